@@ -1,21 +1,29 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 )
 
+// デフォルトの接続先とタイムアウトです。設定ファイルなどで値を明示する際に参照できます。
+const (
+	DefaultBaseURL          = "https://zutool.jp/api"
+	DefaultOtenkiASPBaseURL = "https://ap.otenki.com/OtenkiASP/asp"
+	DefaultTimeout          = 10 * time.Second
+)
+
 const (
-	defaultBaseURL         = "https://zutool.jp/api"
-	defaultOtenkiASPBaseURL = "https://ap.otenki.com/OtenkiASP/asp"
-	defaultTimeout         = 10 * time.Second
 	defaultUserAgent       = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36"
 )
 
@@ -25,69 +33,158 @@ type Client struct {
 	otenkiBaseURL string
 	httpClient  *http.Client
 	userAgent   string
+	header      http.Header // 全リクエストに付与する追加ヘッダー
+	retry       RetryPolicy // 失敗したリクエストの再試行方針
+	cache       Cache       // レスポンスのキャッシュ (nil の場合はキャッシュしない)
+
+	// 以下は New での組み立て時にのみ参照されます
+	timeout   time.Duration
+	transport http.RoundTripper
+	recordDir string // 空でなければリクエストとレスポンスを記録する
+	replayDir string // 空でなければ記録済みのレスポンスを返す
 }
 
 // NewClient は新しいAPIクライアントを作成します。
 // baseURL または otenkiBaseURL が空文字列の場合、デフォルト値が使用されます。
 // timeout がゼロの場合、デフォルトのタイムアウト値が使用されます。
+// より細かい設定が必要な場合は New とオプション関数を使用してください。
 func NewClient(baseURL, otenkiBaseURL string, timeout time.Duration) *Client {
-	if baseURL == "" {
-		baseURL = defaultBaseURL
-	}
-	if otenkiBaseURL == "" {
-		otenkiBaseURL = defaultOtenkiASPBaseURL
+	return New(
+		WithBaseURL(baseURL),
+		WithOtenkiBaseURL(otenkiBaseURL),
+		WithTimeout(timeout),
+	)
+}
+
+// New はオプション関数を適用して新しいAPIクライアントを作成します。
+// オプションは指定された順に適用され、未指定の項目にはデフォルト値が使用されます。
+func New(opts ...Option) *Client {
+	c := &Client{
+		baseURL:       DefaultBaseURL,
+		otenkiBaseURL: DefaultOtenkiASPBaseURL,
+		userAgent:     defaultUserAgent,
+		header:        make(http.Header),
 	}
-	if timeout == 0 {
-		timeout = defaultTimeout
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	return &Client{
-		baseURL:     baseURL,
-		otenkiBaseURL: otenkiBaseURL,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		userAgent: defaultUserAgent,
+	if c.httpClient == nil {
+		timeout := c.timeout
+		if timeout == 0 {
+			timeout = DefaultTimeout
+		}
+		c.httpClient = &http.Client{Timeout: timeout}
+	} else if c.timeout != 0 {
+		// 呼び出し元の http.Client を変更しないようにコピーしてから設定する
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
+	if c.transport != nil {
+		hc := *c.httpClient
+		hc.Transport = c.transport
+		c.httpClient = &hc
 	}
+	if c.replayDir != "" || c.recordDir != "" {
+		hc := *c.httpClient
+		if c.replayDir != "" {
+			hc.Transport = &replayTransport{dir: c.replayDir}
+		}
+		if c.recordDir != "" {
+			next := hc.Transport
+			if next == nil {
+				next = http.DefaultTransport
+			}
+			hc.Transport = &recordingTransport{dir: c.recordDir, next: next}
+		}
+		c.httpClient = &hc
+	}
+
+	return c
 }
 
 // doRequest はHTTPリクエストを実行し、共通のロジック（User-Agent設定、レスポンス読み込み、ステータスコードチェック、エラー処理）を処理するヘルパーメソッドです。
+// 通信エラーやリトライ対象のステータスコードの場合、クライアントの RetryPolicy に従って再試行します。
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	for key, values := range c.header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
 	req.Header.Set("User-Agent", c.userAgent)
+
+	ctx := req.Context()
+	maxAttempts := c.retry.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		resp, body, err := c.doOnce(req.Clone(ctx))
+		if err == nil && resp.StatusCode == http.StatusOK {
+			return body, nil
+		}
+
+		var retryAfter time.Duration
+		retryable := ctx.Err() == nil
+		if err == nil {
+			retryable = retryable && c.retry.retryableStatus(resp.StatusCode)
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
+		if !retryable || attempt >= maxAttempts {
+			if err != nil {
+				if attempt > 1 {
+					return nil, i18n.Errorf("%w (試行回数: %d)", err, attempt)
+				}
+				return nil, err
+			}
+			return nil, newStatusError(resp.StatusCode, body, attempt)
+		}
+
+		if err := sleepContext(ctx, c.retry.backoff(attempt, retryAfter)); err != nil {
+			return nil, i18n.Errorf("リトライ待機中に中断されました (試行回数: %d): %w", attempt, err)
+		}
+	}
+}
+
+// doOnce はリトライを行わずに1回だけリクエストを実行し、レスポンスと読み込んだボディを返します。
+func (c *Client) doOnce(req *http.Request) (*http.Response, []byte, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("リクエストの実行に失敗しました: %w", err)
+		return nil, nil, i18n.Errorf("リクエストの実行に失敗しました: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("レスポンスボディの読み込みに失敗しました: %w", err)
+		return nil, nil, i18n.Errorf("レスポンスボディの読み込みに失敗しました: %w", err)
 	}
+	return resp, body, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		var errorResponse models.ErrorResponse
-		if json.Unmarshal(body, &errorResponse) == nil && errorResponse.ErrorMessage != "" {
-			return nil, newAPIError(resp.StatusCode, string(body), errorResponse.ErrorMessage, nil)
-		}
-		return nil, newAPIError(resp.StatusCode, string(body), "", nil)
+// newStatusError は 200 以外のステータスコードのレスポンスから APIError を生成します。
+// ボディがエラーレスポンス形式の場合はそのメッセージを使用します。
+func newStatusError(statusCode int, body []byte, attempts int) *APIError {
+	var errorResponse models.ErrorResponse
+	message := ""
+	if json.Unmarshal(body, &errorResponse) == nil {
+		message = errorResponse.ErrorMessage
 	}
-
-	return body, nil
+	apiErr := newAPIError(statusCode, string(body), message, nil)
+	apiErr.Attempts = attempts
+	return apiErr
 }
 
 // _get はプライマリAPI (baseURL) から生のレスポンスボディを取得するプライベートヘルパーメソッドです。
 // 200 OKレスポンス内に埋め込まれたAPIエラーもチェックします。
-func (c *Client) _get(path string, param string) ([]byte, error) {
+// ctx のキャンセルや期限はリクエストにそのまま伝播されます。
+func (c *Client) _get(ctx context.Context, path string, param string) ([]byte, error) {
 	apiURL := fmt.Sprintf("%s%s/%s", c.baseURL, path, param)
-	req, err := http.NewRequest("GET", apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("%s のリクエスト作成に失敗しました: %w", path, err)
+		return nil, i18n.Errorf("%s のリクエスト作成に失敗しました: %w", path, err)
 	}
 
 	body, err := c.doRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("%s のリクエストに失敗しました: %w", path, err)
+		return nil, i18n.Errorf("%s のリクエストに失敗しました: %w", path, err)
 	}
 
 	// --- Start: 200 OKレスポンス内のAPIエラーチェック ---
@@ -108,62 +205,99 @@ func (c *Client) _get(path string, param string) ([]byte, error) {
 func (c *Client) GetPainStatus(
 	areaCode string,
 	setWeatherPoint *string,
+) (models.GetPainStatusResponse, error) {
+	return c.GetPainStatusContext(context.Background(), areaCode, setWeatherPoint)
+}
+
+// GetPainStatusContext は ctx を指定して痛み指数情報を取得します。
+// ctx がキャンセルされると、地点設定APIを含む実行中のリクエストは中断されます。
+func (c *Client) GetPainStatusContext(
+	ctx context.Context,
+	areaCode string,
+	setWeatherPoint *string,
 ) (models.GetPainStatusResponse, error) {
 	var result models.GetPainStatusResponse
 
-	// 地点設定API呼び出しロジック
+	// 地点設定の有無で結果が変わるため、キャッシュのキーには地点コードも含める
+	cacheKey := areaCode
 	if setWeatherPoint != nil && *setWeatherPoint != "" {
-		setWeatherPointURL := fmt.Sprintf("%s/setweatherpoint/%s", c.baseURL, *setWeatherPoint)
-		req, err := http.NewRequest("GET", setWeatherPointURL, nil)
-		if err != nil {
-			return result, fmt.Errorf("setweatherpointリクエストの作成に失敗しました: %w", err)
+		cacheKey = areaCode + "@" + *setWeatherPoint
+	}
+
+	body, err := c.cached(EndpointPainStatus, cacheKey, func() ([]byte, error) {
+		// 地点設定API呼び出しロジック
+		if setWeatherPoint != nil && *setWeatherPoint != "" {
+			if err := c.setWeatherPoint(ctx, *setWeatherPoint); err != nil {
+				return nil, err
+			}
 		}
 
-		setBody, err := c.doRequest(req)
+		// 痛み指数API呼び出し
+		body, err := c._get(ctx, "/getpainstatus", areaCode)
 		if err != nil {
 			var apiErr *APIError
-			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
-				return result, fmt.Errorf("地点コード '%s' が見つかりません (setweatherpoint failed with 404)", *setWeatherPoint)
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusOK {
+				return nil, err // 埋め込みエラー
 			}
-			return result, fmt.Errorf("setweatherpointリクエストに失敗しました: %w", err)
+			return nil, i18n.Errorf("痛み指数情報の取得に失敗しました: %w", err)
 		}
+		return body, nil
+	})
+	if err != nil {
+		return result, err
+	}
 
-		var setResp models.SetWeatherPointResponse
-		if err := json.Unmarshal(setBody, &setResp); err != nil {
-			return result, fmt.Errorf("setweatherpointレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(setBody))
-		}
-		if setResp.Response != "ok" {
-			// TODO: より具体的なエラーを返すことを検討
-			return result, fmt.Errorf("setweatherpointレスポンスが 'ok' ではありませんでした: %s", setResp.Response)
-		}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return result, i18n.Errorf("GetPainStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
 	}
 
-	// 痛み指数API呼び出し
-	body, err := c._get("/getpainstatus", areaCode)
+	return result, nil
+}
+
+// setWeatherPoint は地点設定API (/setweatherpoint) を呼び出します。
+func (c *Client) setWeatherPoint(ctx context.Context, cityCode string) error {
+	setWeatherPointURL := fmt.Sprintf("%s/setweatherpoint/%s", c.baseURL, cityCode)
+	req, err := http.NewRequestWithContext(ctx, "GET", setWeatherPointURL, nil)
+	if err != nil {
+		return i18n.Errorf("setweatherpointリクエストの作成に失敗しました: %w", err)
+	}
+
+	setBody, err := c.doRequest(req)
 	if err != nil {
 		var apiErr *APIError
-		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusOK {
-			return result, err // 埋め込みエラー
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return i18n.Errorf("地点コード '%s' が見つかりません (setweatherpoint failed with 404)", cityCode)
 		}
-		return result, fmt.Errorf("痛み指数情報の取得に失敗しました: %w", err)
+		return i18n.Errorf("setweatherpointリクエストに失敗しました: %w", err)
 	}
 
-	if err := json.Unmarshal(body, &result); err != nil {
-		return result, fmt.Errorf("GetPainStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
+	var setResp models.SetWeatherPointResponse
+	if err := json.Unmarshal(setBody, &setResp); err != nil {
+		return i18n.Errorf("setweatherpointレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(setBody))
 	}
-
-	return result, nil
+	if setResp.Response != "ok" {
+		// TODO: より具体的なエラーを返すことを検討
+		return i18n.Errorf("setweatherpointレスポンスが 'ok' ではありませんでした: %s", setResp.Response)
+	}
+	return nil
 }
 
 // GetWeatherPoint は地点検索情報を取得します。
 func (c *Client) GetWeatherPoint(keyword string) (models.GetWeatherPointResponse, error) {
-	body, err := c._get("/getweatherpoint", keyword)
+	return c.GetWeatherPointContext(context.Background(), keyword)
+}
+
+// GetWeatherPointContext は ctx を指定して地点検索情報を取得します。
+func (c *Client) GetWeatherPointContext(ctx context.Context, keyword string) (models.GetWeatherPointResponse, error) {
+	body, err := c.cached(EndpointWeatherPoint, keyword, func() ([]byte, error) {
+		return c._get(ctx, "/getweatherpoint", keyword)
+	})
 	if err != nil {
 		var apiErr *APIError
 		if errors.As(err, &apiErr) {
 			return models.GetWeatherPointResponse{}, err // APIエラーはそのまま返す
 		}
-		return models.GetWeatherPointResponse{}, fmt.Errorf("/getweatherpoint のリクエストに失敗しました: %w", err)
+		return models.GetWeatherPointResponse{}, i18n.Errorf("/getweatherpoint のリクエストに失敗しました: %w", err)
 	}
 
 	return parseWeatherPointResponse(body)
@@ -171,19 +305,26 @@ func (c *Client) GetWeatherPoint(keyword string) (models.GetWeatherPointResponse
 
 // GetWeatherStatus は詳細な気象状況を取得します。
 func (c *Client) GetWeatherStatus(cityCode string) (models.GetWeatherStatusResponse, error) {
+	return c.GetWeatherStatusContext(context.Background(), cityCode)
+}
+
+// GetWeatherStatusContext は ctx を指定して詳細な気象状況を取得します。
+func (c *Client) GetWeatherStatusContext(ctx context.Context, cityCode string) (models.GetWeatherStatusResponse, error) {
 	var result models.GetWeatherStatusResponse
 
-	body, err := c._get("/getweatherstatus", cityCode)
+	body, err := c.cached(EndpointWeatherStatus, cityCode, func() ([]byte, error) {
+		return c._get(ctx, "/getweatherstatus", cityCode)
+	})
 	if err != nil {
 		var apiErr *APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusOK {
 			return result, err // 埋め込みエラー
 		}
-		return result, fmt.Errorf("気象状況の取得に失敗しました: %w", err)
+		return result, i18n.Errorf("気象状況の取得に失敗しました: %w", err)
 	}
 
 	if err := json.Unmarshal(body, &result); err != nil {
-		return result, fmt.Errorf("GetWeatherStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
+		return result, i18n.Errorf("GetWeatherStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
 	}
 
 	return result, nil
@@ -191,29 +332,76 @@ func (c *Client) GetWeatherStatus(cityCode string) (models.GetWeatherStatusRespo
 
 // GetOtenkiASP はOtenki ASPから気象情報を取得します。
 func (c *Client) GetOtenkiASP(cityCode string) (models.GetOtenkiASPResponse, error) {
+	return c.GetOtenkiASPContext(context.Background(), cityCode)
+}
+
+// GetOtenkiASPContext は ctx を指定してOtenki ASPから気象情報を取得します。
+// デフォルトのコンテンツ (models.DefaultOtenkiContentIDs) を7日分取得します。
+func (c *Client) GetOtenkiASPContext(ctx context.Context, cityCode string) (models.GetOtenkiASPResponse, error) {
+	return c.GetOtenkiASPWithOptionsContext(ctx, cityCode, OtenkiASPOptions{})
+}
+
+// GetOtenkiASPWithOptions は取得するコンテンツと期間を指定してOtenki ASPから気象情報を取得します。
+func (c *Client) GetOtenkiASPWithOptions(cityCode string, opts OtenkiASPOptions) (models.GetOtenkiASPResponse, error) {
+	return c.GetOtenkiASPWithOptionsContext(context.Background(), cityCode, opts)
+}
+
+// GetOtenkiASPWithOptionsContext は ctx と取得するコンテンツ・期間を指定してOtenki ASPから気象情報を取得します。
+func (c *Client) GetOtenkiASPWithOptionsContext(ctx context.Context, cityCode string, opts OtenkiASPOptions) (models.GetOtenkiASPResponse, error) {
+	if opts.Duration < 0 {
+		return models.GetOtenkiASPResponse{}, i18n.Errorf("無効な予報日数です: %d", opts.Duration)
+	}
 	params := url.Values{}
+	for key, values := range opts.Params {
+		params[key] = append([]string(nil), values...)
+	}
 	params.Set("csid", "mmcm")
-	params.Set("contents_id", "day_tenki--day_pre--hight_temp--low_temp--day_wind_v--day_wind_d--zutu_level_day--low_humidity")
-	params.Set("duration_yohoushi", "7")
+	params.Set("contents_id", strings.Join(opts.contentIDs(), "--"))
+	params.Set("duration_yohoushi", strconv.Itoa(opts.duration()))
 	params.Set("where", fmt.Sprintf("CHITEN_%s", cityCode))
 	params.Set("json", "on")
 
 	apiURL := fmt.Sprintf("%s/getElements", c.otenkiBaseURL)
 	u, err := url.Parse(apiURL)
 	if err != nil {
-		return models.GetOtenkiASPResponse{}, fmt.Errorf("Otenki ASP URLのパースに失敗しました: %w", err)
+		return models.GetOtenkiASPResponse{}, i18n.Errorf("Otenki ASP URLのパースに失敗しました: %w", err)
 	}
 	u.RawQuery = params.Encode()
 
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return models.GetOtenkiASPResponse{}, fmt.Errorf("Otenki ASPリクエストの作成に失敗しました: %w", err)
-	}
+	body, err := c.cached(EndpointOtenkiASP, u.RawQuery, func() ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+		if err != nil {
+			return nil, i18n.Errorf("Otenki ASPリクエストの作成に失敗しました: %w", err)
+		}
 
-	body, err := c.doRequest(req)
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, i18n.Errorf("Otenki ASPリクエストに失敗しました: %w", err)
+		}
+		return body, nil
+	})
 	if err != nil {
-		return models.GetOtenkiASPResponse{}, fmt.Errorf("Otenki ASPリクエストに失敗しました: %w", err)
+		return models.GetOtenkiASPResponse{}, err
 	}
 
 	return parseOtenkiASPResponse(body)
 }
+
+// GetDailyForecast は Otenki ASP の予報を日別の型付きモデルとして取得します。
+func (c *Client) GetDailyForecast(cityCode string) ([]models.DailyForecast, error) {
+	return c.GetDailyForecastContext(context.Background(), cityCode)
+}
+
+// GetDailyForecastContext は ctx を指定して Otenki ASP の予報を日別の型付きモデルとして取得します。
+// GetOtenkiASPContext の結果を日付ごとにまとめるため、利用側で Records の値を型アサーションする必要はありません。
+func (c *Client) GetDailyForecastContext(ctx context.Context, cityCode string) ([]models.DailyForecast, error) {
+	res, err := c.GetOtenkiASPContext(ctx, cityCode)
+	if err != nil {
+		return nil, err
+	}
+	forecasts, err := res.DailyForecasts()
+	if err != nil {
+		return nil, i18n.Errorf("Otenki ASP レスポンスの日別予報への変換に失敗しました: %w", err)
+	}
+	return forecasts, nil
+}
@@ -1,25 +1,32 @@
 package api_test
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/api/apitest"
+	"github.com/eraiza0816/zu2l/internal/models"
 )
 
 // テスト用の新しいクライアントを作成するヘルパー関数
-func newTestClient() *api.Client {
-	// 実際のAPIに対してテストするためにデフォルトのURLとタイムアウトを使用
-	// 将来的には、より制御されたテストのためにモックサーバーの使用を検討
-	return api.NewClient("", "", 0)
+// 実際のAPIではなく、記録済みのフィクスチャを返す偽のサーバー (apitest) に接続するため、オフラインでも決定的に動作します。
+func newTestClient(t *testing.T) *api.Client {
+	return apitest.NewServer(t).Client()
 }
 
 // --- APIクライアントテスト ---
 
 // TestGetPainStatus は GetPainStatus の正常系テストです。
 func TestGetPainStatus(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	areaCode := "13" // 東京都
 	_, err := client.GetPainStatus(areaCode, nil)
 	if err != nil {
@@ -29,7 +36,7 @@ func TestGetPainStatus(t *testing.T) {
 
 // TestGetPainStatusInvalid は GetPainStatus の異常系テスト (無効なエリアコード) です。
 func TestGetPainStatusInvalid(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	areaCode := "1" // 無効なコード
 	_, err := client.GetPainStatus(areaCode, nil)
 	if err == nil {
@@ -51,7 +58,8 @@ func TestGetPainStatusInvalid(t *testing.T) {
 
 // TestGetPainStatusSetWeatherPoint は GetPainStatus で setWeatherPoint を指定した場合のテストです。
 func TestGetPainStatusSetWeatherPoint(t *testing.T) {
-	client := newTestClient()
+	server := apitest.NewServer(t)
+	client := server.Client()
 
 	cityCode1 := "01101" // 札幌
 	setWeatherPoint1 := cityCode1
@@ -74,11 +82,14 @@ func TestGetPainStatusSetWeatherPoint(t *testing.T) {
 	} else if res2.PainnoterateStatus.AreaName != expectedAreaName2 {
 		t.Errorf("client.GetPainStatus(%q, setWeatherPoint=%q) が予期しない AreaName を返しました: %q, 期待値: %q", areaCode2, setWeatherPoint2, res2.PainnoterateStatus.AreaName, expectedAreaName2)
 	}
+	if got := server.WeatherPoint(); got != cityCode2 {
+		t.Errorf("地点設定APIに送られた地点コードが %q でした。期待値: %q", got, cityCode2)
+	}
 }
 
 // TestGetWeatherPoint は GetWeatherPoint の正常系テストです。
 func TestGetWeatherPoint(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	keyword := "神戸市"
 	res, err := client.GetWeatherPoint(keyword)
 	if err != nil {
@@ -89,31 +100,24 @@ func TestGetWeatherPoint(t *testing.T) {
 	}
 }
 
-// TestGetWeatherPointExtra は GetWeatherPoint で結果が少ない、または無い場合のテストです。
+// TestGetWeatherPointExtra は GetWeatherPoint で該当する地点が無い場合のテストです。
 func TestGetWeatherPointExtra(t *testing.T) {
-	client := newTestClient()
-	keyword := "a" // ほとんど、または全く結果が返らないと予想されるキーワード
+	client := newTestClient(t)
+	keyword := "a" // 該当する地点が無いキーワード
 	res, err := client.GetWeatherPoint(keyword)
 	if err != nil {
-		// APIの挙動によっては、ここでのエラーも許容される可能性があります
-		t.Logf("client.GetWeatherPoint(%q) が期待通り失敗した可能性があります: %v", keyword, err)
-		var apiErr *api.APIError
-		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
-			// 404が「見つかりません」を意味する場合、これはパスと見なせる
-			return
-		}
 		t.Errorf("client.GetWeatherPoint(%q) が予期せず失敗しました: %v", keyword, err)
 		return
 	}
 	// 元のPythonテストに基づくチェック
 	if len(res.Result.Root) != 0 {
-		t.Errorf("client.GetWeatherPoint(%q) が %d 件の結果を返しました。0件または404エラーを期待していました", keyword, len(res.Result.Root))
+		t.Errorf("client.GetWeatherPoint(%q) が %d 件の結果を返しました。0件を期待していました", keyword, len(res.Result.Root))
 	}
 }
 
 // TestGetWeatherPointEmpty は GetWeatherPoint で空のキーワードを指定した場合のテストです。
 func TestGetWeatherPointEmpty(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	keyword := ""
 	_, err := client.GetWeatherPoint(keyword)
 	if err == nil {
@@ -133,7 +137,7 @@ func TestGetWeatherPointEmpty(t *testing.T) {
 
 // TestGetWeatherStatus は GetWeatherStatus の正常系テストです。
 func TestGetWeatherStatus(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := "13113" // 渋谷
 	res, err := client.GetWeatherStatus(cityCode)
 	if err != nil {
@@ -148,7 +152,7 @@ func TestGetWeatherStatus(t *testing.T) {
 
 // TestGetWeatherStatusInvalidCode は GetWeatherStatus の異常系テスト (無効な形式のコード) です。
 func TestGetWeatherStatusInvalidCode(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := "aaaaa" // 無効な形式のコード
 	_, err := client.GetWeatherStatus(cityCode)
 	if err == nil {
@@ -168,7 +172,7 @@ func TestGetWeatherStatusInvalidCode(t *testing.T) {
 
 // TestGetWeatherStatusInvalidDigit は GetWeatherStatus の異常系テスト (桁数が不正なコード) です。
 func TestGetWeatherStatusInvalidDigit(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := "13" // 桁数が足りないコード
 	_, err := client.GetWeatherStatus(cityCode)
 	if err == nil {
@@ -188,7 +192,7 @@ func TestGetWeatherStatusInvalidDigit(t *testing.T) {
 
 // TestGetOtenkiASP は GetOtenkiASP の正常系テストです。
 func TestGetOtenkiASP(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := "13101" // 東京
 	res, err := client.GetOtenkiASP(cityCode)
 	if err != nil {
@@ -197,11 +201,89 @@ func TestGetOtenkiASP(t *testing.T) {
 	if res.Status != "OK" { // models.GetOtenkiASPResponse に Status フィールドがあると仮定
 		t.Errorf("client.GetOtenkiASP(%q) がステータス %q を返しました。期待値: %q", cityCode, res.Status, "OK")
 	}
+	if len(res.Elements) != 8 {
+		t.Errorf("client.GetOtenkiASP(%q) が %d 個の要素を返しました。期待値: 8", cityCode, len(res.Elements))
+	}
+}
+
+// TestGetDailyForecast は GetDailyForecast が日別の型付きモデルを返すことを確認します。
+func TestGetDailyForecast(t *testing.T) {
+	client := newTestClient(t)
+	cityCode := "13101" // 東京
+	forecasts, err := client.GetDailyForecast(cityCode)
+	if err != nil {
+		t.Fatalf("client.GetDailyForecast(%q) が失敗しました: %v", cityCode, err)
+	}
+	if len(forecasts) != 7 {
+		t.Fatalf("client.GetDailyForecast(%q) が %d 日分を返しました。期待値: 7", cityCode, len(forecasts))
+	}
+	first := forecasts[0]
+	if first.Weather != models.Sunny || first.HighTemp == nil || *first.HighTemp != 24.5 || first.HeadacheLevel == nil {
+		t.Errorf("client.GetDailyForecast(%q) の初日の予報が期待と異なります: %+v", cityCode, first)
+	}
+	if !first.Date.Before(forecasts[1].Date) {
+		t.Errorf("client.GetDailyForecast(%q) の結果が日付順ではありません", cityCode)
+	}
+}
+
+// TestGetOtenkiASPWithOptions はコンテンツIDと予報日数を指定して取得できることを確認します。
+func TestGetOtenkiASPWithOptions(t *testing.T) {
+	client := newTestClient(t)
+	cityCode := "13101" // 東京
+	opts := api.OtenkiASPOptions{ContentIDs: []string{models.OtenkiContentHighTemp, models.OtenkiContentWeather}, Duration: 3}
+	res, err := client.GetOtenkiASPWithOptions(cityCode, opts)
+	if err != nil {
+		t.Fatalf("client.GetOtenkiASPWithOptions(%q) が失敗しました: %v", cityCode, err)
+	}
+	if len(res.Elements) != 2 {
+		t.Fatalf("client.GetOtenkiASPWithOptions(%q) が %d 個の要素を返しました。期待値: 2", cityCode, len(res.Elements))
+	}
+	if res.Elements[0].ContentID != models.OtenkiContentHighTemp || res.Elements[1].ContentID != models.OtenkiContentWeather {
+		t.Errorf("client.GetOtenkiASPWithOptions(%q) の要素の順序が期待と異なります: %q, %q", cityCode, res.Elements[0].ContentID, res.Elements[1].ContentID)
+	}
+	if len(res.Elements[0].Records) != 3 {
+		t.Errorf("client.GetOtenkiASPWithOptions(%q) が %d 日分を返しました。期待値: 3", cityCode, len(res.Elements[0].Records))
+	}
+
+	_, err = client.GetOtenkiASPWithOptions(cityCode, api.OtenkiASPOptions{Duration: -1})
+	if err == nil {
+		t.Errorf("負の予報日数では失敗するはずですが、nil エラーが返されました")
+	}
+}
+
+// TestGetOtenkiASPWithOptionsQuery は指定したオプションがクエリパラメータとして送信されることを確認します。
+func TestGetOtenkiASPWithOptionsQuery(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","head":{},"body":{"location":{"element":[]}}}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient("", server.URL, 5*time.Second)
+	params := url.Values{"csid": {"ignored"}, "extra": {"1"}}
+	_, err := client.GetOtenkiASPWithOptions("13101", api.OtenkiASPOptions{ContentIDs: []string{"day_pre", "low_temp"}, Duration: 2, Params: params})
+	if err != nil {
+		t.Fatalf("client.GetOtenkiASPWithOptions が失敗しました: %v", err)
+	}
+	expected := map[string]string{
+		"contents_id":       "day_pre--low_temp",
+		"duration_yohoushi": "2",
+		"where":             "CHITEN_13101",
+		"csid":              "mmcm",
+		"extra":             "1",
+	}
+	for key, want := range expected {
+		if got := query.Get(key); got != want {
+			t.Errorf("クエリパラメータ %s = %q、期待値: %q", key, got, want)
+		}
+	}
 }
 
 // TestGetOtenkiASPInvalidCode は GetOtenkiASP の異常系テスト (無効なコード) です。
 func TestGetOtenkiASPInvalidCode(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := "13000" // 元のテストに基づき、OtenkiASP にとって無効なコード
 	_, err := client.GetOtenkiASP(cityCode)
 	if err == nil {
@@ -211,10 +293,280 @@ func TestGetOtenkiASPInvalidCode(t *testing.T) {
 
 // TestGetOtenkiASPEmpty は GetOtenkiASP で空のコードを指定した場合のテストです。
 func TestGetOtenkiASPEmpty(t *testing.T) {
-	client := newTestClient()
+	client := newTestClient(t)
 	cityCode := ""
 	_, err := client.GetOtenkiASP(cityCode)
 	if err == nil {
 		t.Errorf("client.GetOtenkiASP(%q) は失敗するはずですが、nil エラーが返されました", cityCode)
 	}
 }
+
+// TestGetWeatherStatusContextCanceled はキャンセル済みの ctx を渡した場合にリクエストが中断されることを確認します。
+func TestGetWeatherStatusContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	client := api.NewClient(server.URL, server.URL, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.GetWeatherStatusContext(ctx, "13113")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("client.GetWeatherStatusContext がキャンセル済み ctx で予期しないエラーを返しました: %v, 期待値: context.Canceled", err)
+	}
+}
+
+// TestGetPainStatusContextDeadline は ctx の期限が地点設定APIの呼び出しにも伝播されることを確認します。
+func TestGetPainStatusContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	client := api.NewClient(server.URL, server.URL, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	setWeatherPoint := "13113"
+	_, err := client.GetPainStatusContext(ctx, "13", &setWeatherPoint)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("client.GetPainStatusContext が期限切れ ctx で予期しないエラーを返しました: %v, 期待値: context.DeadlineExceeded", err)
+	}
+}
+
+// roundTripFunc は関数を http.RoundTripper として扱うためのテスト用の型です。
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestNewWithUserAgentAndHeader は WithUserAgent と WithHeader がリクエストに反映されることを確認します。
+func TestNewWithUserAgentAndHeader(t *testing.T) {
+	var gotUA, gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		gotHeader = r.Header.Get("X-Team")
+		w.Write([]byte(`{"painnoterate_status":{"area_name":"東京都"}}`))
+	}))
+	defer server.Close()
+
+	client := api.New(
+		api.WithBaseURL(server.URL),
+		api.WithUserAgent("zu2l-test/1.0"),
+		api.WithHeader("X-Team", "weather"),
+	)
+	if _, err := client.GetPainStatus("13", nil); err != nil {
+		t.Fatalf("client.GetPainStatus が失敗しました: %v", err)
+	}
+	if gotUA != "zu2l-test/1.0" {
+		t.Errorf("User-Agent = %q, 期待値: %q", gotUA, "zu2l-test/1.0")
+	}
+	if gotHeader != "weather" {
+		t.Errorf("X-Team = %q, 期待値: %q", gotHeader, "weather")
+	}
+}
+
+// TestNewWithTransport は WithTransport で差し込んだ RoundTripper が使用されることを確認します。
+func TestNewWithTransport(t *testing.T) {
+	var gotURL string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"place_name":"渋谷区","place_id":"113","prefectures_id":"13"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+
+	httpClient := &http.Client{}
+	client := api.New(
+		api.WithBaseURL("http://example.invalid/api"),
+		api.WithHTTPClient(httpClient),
+		api.WithTransport(transport),
+	)
+	res, err := client.GetWeatherStatus("13113")
+	if err != nil {
+		t.Fatalf("client.GetWeatherStatus が失敗しました: %v", err)
+	}
+	if gotURL != "http://example.invalid/api/getweatherstatus/13113" {
+		t.Errorf("リクエストURL = %q, 期待値: %q", gotURL, "http://example.invalid/api/getweatherstatus/13113")
+	}
+	if res.PlaceName != "渋谷区" {
+		t.Errorf("PlaceName = %q, 期待値: %q", res.PlaceName, "渋谷区")
+	}
+	if httpClient.Transport != nil {
+		t.Errorf("WithTransport が呼び出し元の http.Client を変更しました")
+	}
+}
+
+// newRetryTestPolicy はテスト用に待機時間を短くしたリトライ方針を返します。
+func newRetryTestPolicy(maxAttempts int) api.RetryPolicy {
+	policy := api.DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	return policy
+}
+
+// TestRetryRecoversFrom5xx は一時的な 5xx の後に成功した場合、結果が返されることを確認します。
+func TestRetryRecoversFrom5xx(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"place_name":"渋谷区","place_id":"113","prefectures_id":"13"}`))
+	}))
+	defer server.Close()
+
+	client := api.New(api.WithBaseURL(server.URL), api.WithRetryPolicy(newRetryTestPolicy(3)))
+	res, err := client.GetWeatherStatus("13113")
+	if err != nil {
+		t.Fatalf("client.GetWeatherStatus がリトライ後に失敗しました: %v", err)
+	}
+	if res.PlaceName != "渋谷区" {
+		t.Errorf("PlaceName = %q, 期待値: %q", res.PlaceName, "渋谷区")
+	}
+	if calls != 3 {
+		t.Errorf("リクエスト回数 = %d, 期待値: 3", calls)
+	}
+}
+
+// TestRetryExhausted はリトライ回数を使い切った場合に APIError に試行回数が含まれることを確認します。
+func TestRetryExhausted(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	setWeatherPoint := "13113"
+	client := api.New(api.WithBaseURL(server.URL), api.WithRetryPolicy(newRetryTestPolicy(3)))
+	_, err := client.GetPainStatus("13", &setWeatherPoint)
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("client.GetPainStatus が予期しないエラー型で失敗しました: %T (%v), 期待する型: *api.APIError", err, err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError || apiErr.Attempts != 3 {
+		t.Errorf("APIError = {StatusCode: %d, Attempts: %d}, 期待値: {StatusCode: 500, Attempts: 3}", apiErr.StatusCode, apiErr.Attempts)
+	}
+	if calls != 3 {
+		t.Errorf("リクエスト回数 = %d, 期待値: 3", calls)
+	}
+}
+
+// TestRetryNotForClientErrors はリトライ対象外のステータスコードでは再試行しないことを確認します。
+func TestRetryNotForClientErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := api.New(api.WithBaseURL(server.URL), api.WithRetryPolicy(newRetryTestPolicy(3)))
+	_, err := client.GetWeatherPoint("")
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Attempts != 1 {
+		t.Errorf("client.GetWeatherPoint が予期しないエラーを返しました: %v, 期待値: 試行回数1の404 APIError", err)
+	}
+	if calls != 1 {
+		t.Errorf("リクエスト回数 = %d, 期待値: 1", calls)
+	}
+}
+
+// memoryCache はテスト用のメモリ上の api.Cache 実装です。
+type memoryCache map[string][]byte
+
+func (m memoryCache) Get(endpoint, key string) ([]byte, bool) {
+	body, ok := m[endpoint+"/"+key]
+	return body, ok
+}
+
+func (m memoryCache) Set(endpoint, key string, body []byte) error {
+	m[endpoint+"/"+key] = body
+	return nil
+}
+
+// TestWithCache はキャッシュ済みのレスポンスが再利用され、地点設定の有無がキーで区別されることを確認します。
+func TestWithCache(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/setweatherpoint/"):
+			w.Write([]byte(`{"response":"ok"}`))
+		case r.URL.Path == "/getpainstatus/1":
+			w.Write([]byte(`{"error_code":1,"error_message":"存在しない都道府県コードです"}`))
+		default:
+			w.Write([]byte(`{"painnoterate_status":{"area_name":"東京都"}}`))
+		}
+	}))
+	defer server.Close()
+
+	cache := memoryCache{}
+	client := api.New(api.WithBaseURL(server.URL), api.WithCache(cache))
+	setWeatherPoint := "13113"
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetPainStatus("13", nil); err != nil {
+			t.Fatalf("client.GetPainStatus が失敗しました: %v", err)
+		}
+		if _, err := client.GetPainStatus("13", &setWeatherPoint); err != nil {
+			t.Fatalf("client.GetPainStatus(setWeatherPoint) が失敗しました: %v", err)
+		}
+		if _, err := client.GetPainStatus("1", nil); err == nil {
+			t.Fatalf("client.GetPainStatus(%q) は失敗するはずですが、nil エラーが返されました", "1")
+		}
+	}
+
+	if requests["/getpainstatus/13"] != 2 {
+		t.Errorf("/getpainstatus/13 のリクエスト回数 = %d, 期待値: 2 (地点設定あり/なしで1回ずつ)", requests["/getpainstatus/13"])
+	}
+	if requests["/setweatherpoint/13113"] != 1 {
+		t.Errorf("/setweatherpoint/13113 のリクエスト回数 = %d, 期待値: 1", requests["/setweatherpoint/13113"])
+	}
+	if requests["/getpainstatus/1"] != 2 {
+		t.Errorf("/getpainstatus/1 のリクエスト回数 = %d, 期待値: 2 (エラーはキャッシュされない)", requests["/getpainstatus/1"])
+	}
+}
+
+// TestWithCacheBaseURL は接続先の異なるクライアントが同じキャッシュを共有しても、互いのレスポンスを返さないことを確認します。
+func TestWithCacheBaseURL(t *testing.T) {
+	newServer := func(areaName string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"painnoterate_status":{"area_name":"` + areaName + `"}}`))
+		}))
+	}
+	first := newServer("東京都")
+	defer first.Close()
+	second := newServer("大阪府")
+	defer second.Close()
+
+	cache := memoryCache{}
+	for _, tc := range []struct {
+		server *httptest.Server
+		want   string
+	}{
+		{first, "東京都"},
+		{second, "大阪府"},
+		{first, "東京都"},
+	} {
+		client := api.New(api.WithBaseURL(tc.server.URL), api.WithCache(cache))
+		status, err := client.GetPainStatus("13", nil)
+		if err != nil {
+			t.Fatalf("client.GetPainStatus が失敗しました: %v", err)
+		}
+		if status.PainnoterateStatus.AreaName != tc.want {
+			t.Errorf("%s の AreaName = %q, 期待値: %q", tc.server.URL, status.PainnoterateStatus.AreaName, tc.want)
+		}
+	}
+	if len(cache) != 2 {
+		t.Errorf("キャッシュのエントリ数 = %d, 期待値: 2", len(cache))
+	}
+}
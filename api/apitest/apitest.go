@@ -0,0 +1,214 @@
+// Package apitest は zutool API と Otenki ASP API を模倣するテスト用のHTTPサーバーを提供します。
+//
+// サーバーは testdata 配下に埋め込まれた記録済みのレスポンス (フィクスチャ) を返すため、
+// ネットワークに接続せずに api.Client を決定的にテストできます。
+// 存在しないコードに対しては実際のAPIと同じ形のエラー (200 レスポンス内の error_message や 404) を返します。
+package apitest
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// BasePath と OtenkiBasePath はサーバー上の各APIのベースパスです。実際のAPIのパスに合わせています。
+const (
+	BasePath       = "/api"
+	OtenkiBasePath = "/OtenkiASP/asp"
+)
+
+// 実際のAPIが返すエラーメッセージ
+const (
+	ErrMessageUnknownArea      = "存在しない都道府県コードです"
+	ErrMessageUnknownPlace     = "地点名称が取得できませんでした"
+	ErrMessageInvalidCityDigit = "地点コードの桁数が正しくありません"
+)
+
+//go:embed testdata
+var fixtures embed.FS
+
+// Server は記録済みのフィクスチャを返す偽の zutool / Otenki ASP サーバーです。
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	requests     []string // 受信したリクエストのパス (クエリを含む)
+	weatherPoint string   // 最後に /setweatherpoint で設定された地点コード
+}
+
+// NewServer は偽のサーバーを起動します。サーバーはテスト終了時に自動的に停止します。
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{}
+	s.Server = httptest.NewServer(s.handler())
+	t.Cleanup(s.Close)
+	return s
+}
+
+// BaseURL は zutool API のベースURL (api.WithBaseURL に渡す値) を返します。
+func (s *Server) BaseURL() string {
+	return s.URL + BasePath
+}
+
+// OtenkiBaseURL は Otenki ASP API のベースURL (api.WithOtenkiBaseURL に渡す値) を返します。
+func (s *Server) OtenkiBaseURL() string {
+	return s.URL + OtenkiBasePath
+}
+
+// Client はこのサーバーに接続する api.Client を作成します。opts は既定の接続先の後に適用されます。
+func (s *Server) Client(opts ...api.Option) *api.Client {
+	return api.New(append([]api.Option{
+		api.WithBaseURL(s.BaseURL()),
+		api.WithOtenkiBaseURL(s.OtenkiBaseURL()),
+	}, opts...)...)
+}
+
+// Requests はこれまでに受信したリクエストのパスを受信順に返します。
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// WeatherPoint は最後に /setweatherpoint で設定された地点コードを返します。
+func (s *Server) WeatherPoint() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.weatherPoint
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+BasePath+"/getpainstatus/{code}", func(w http.ResponseWriter, r *http.Request) {
+		code := r.PathValue("code")
+		if !serveFixture(w, path.Join("getpainstatus", code+".json")) {
+			writeAPIError(w, ErrMessageUnknownArea)
+		}
+	})
+	mux.HandleFunc("GET "+BasePath+"/getweatherpoint/{keyword}", func(w http.ResponseWriter, r *http.Request) {
+		if !serveFixture(w, path.Join("getweatherpoint", r.PathValue("keyword")+".json")) {
+			writeJSON(w, http.StatusOK, map[string]string{"result": "[]"})
+		}
+	})
+	mux.HandleFunc("GET "+BasePath+"/getweatherstatus/{code}", func(w http.ResponseWriter, r *http.Request) {
+		code := r.PathValue("code")
+		if len(code) != 5 {
+			writeAPIError(w, ErrMessageInvalidCityDigit)
+			return
+		}
+		if !serveFixture(w, path.Join("getweatherstatus", code+".json")) {
+			writeAPIError(w, ErrMessageUnknownPlace)
+		}
+	})
+	mux.HandleFunc("GET "+BasePath+"/setweatherpoint/{code}", func(w http.ResponseWriter, r *http.Request) {
+		code := r.PathValue("code")
+		if len(code) != 5 || strings.Trim(code, "0123456789") != "" {
+			http.NotFound(w, r)
+			return
+		}
+		s.mu.Lock()
+		s.weatherPoint = code
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, map[string]string{"response": "ok"})
+	})
+	mux.HandleFunc("GET "+OtenkiBasePath+"/getElements", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		code, ok := strings.CutPrefix(query.Get("where"), "CHITEN_")
+		if !ok || code == "" {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := Fixture(path.Join("otenki", code+".json"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		filtered, err := filterOtenkiElements(body, strings.Split(query.Get("contents_id"), "--"), query.Get("duration_yohoushi"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, filtered)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.URL.RequestURI())
+		s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// Fixture は埋め込まれたフィクスチャ (例: "getweatherstatus/13113.json") の内容を返します。
+func Fixture(name string) ([]byte, error) {
+	return fs.ReadFile(fixtures, path.Join("testdata", name))
+}
+
+// serveFixture はフィクスチャが存在すればそれを返し、true を返します。
+func serveFixture(w http.ResponseWriter, name string) bool {
+	body, err := Fixture(name)
+	if err != nil {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+	return true
+}
+
+// filterOtenkiElements は Otenki ASP のフィクスチャから、要求されたコンテンツIDの要素を要求された順に、
+// 予報日数 (duration) 分のレコードに絞り込みます。フィクスチャに無いコンテンツIDは無視します。
+func filterOtenkiElements(body []byte, contentIDs []string, duration string) (*models.GetOtenkiASPRawResponse, error) {
+	var raw models.GetOtenkiASPRawResponse
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+	days, err := strconv.Atoi(duration)
+	if err != nil || days < 1 {
+		days = -1 // 不正な値の場合は全日分を返す
+	}
+
+	byID := make(map[string]models.RawRecord)
+	for _, elem := range raw.Body.Location.Element {
+		if len(elem.Record) > 0 && len(elem.Record[0].Property) > 0 {
+			if id, ok := elem.Record[0].Property[0].(string); ok {
+				byID[id] = elem
+			}
+		}
+	}
+	elements := make([]models.RawRecord, 0, len(contentIDs))
+	for _, id := range contentIDs {
+		elem, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if days >= 0 && len(elem.Record) > days+1 {
+			elem.Record = elem.Record[:days+1] // ヘッダーレコード + 予報日数分
+		}
+		elements = append(elements, elem)
+	}
+	raw.Head.ContentsID = strings.Join(contentIDs, "--")
+	raw.Body.Location.Element = elements
+	return &raw, nil
+}
+
+// writeAPIError は実際のAPIと同様に、200 レスポンスの本文に error_message を埋め込んで返します。
+func writeAPIError(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, map[string]any{"error_code": 1, "error_message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,55 @@
+package api
+
+// キャッシュのキーや TTL の指定に使用するエンドポイント名です。
+const (
+	EndpointPainStatus    = "getpainstatus"
+	EndpointWeatherPoint  = "getweatherpoint"
+	EndpointWeatherStatus = "getweatherstatus"
+	EndpointOtenkiASP     = "otenki_asp"
+)
+
+// Cache は API の生レスポンスボディを保存するキャッシュのインターフェースです。
+// 有効期限の管理は実装側の責務です (例: internal/cache.Store はエンドポイントごとの TTL を持ちます)。
+type Cache interface {
+	// Get は endpoint と key に対応する有効なボディを返します。無い場合や期限切れの場合は false を返します。
+	Get(endpoint, key string) ([]byte, bool)
+	// Set は endpoint と key に対応するボディを保存します。
+	Set(endpoint, key string, body []byte) error
+}
+
+// WithCache はレスポンスのキャッシュを設定します。
+// 正常なレスポンスのみが保存され、エラーレスポンスはキャッシュされません。
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// cached はキャッシュにボディがあればそれを返し、無ければ fetch を呼び出して結果を保存します。
+// キャッシュが設定されていない場合は常に fetch を呼び出します。
+// 接続先の異なるクライアントが同じキャッシュを共有しても混ざらないよう、key には接続先のベース URL を含めます。
+func (c *Client) cached(endpoint, key string, fetch func() ([]byte, error)) ([]byte, error) {
+	if c.cache == nil {
+		return fetch()
+	}
+	key = c.cacheBaseURL(endpoint) + " " + key
+	if body, ok := c.cache.Get(endpoint, key); ok {
+		return body, nil
+	}
+
+	body, err := fetch()
+	if err != nil {
+		return body, err
+	}
+	// キャッシュへの保存に失敗しても取得結果は有効なため、エラーは無視する
+	_ = c.cache.Set(endpoint, key, body)
+	return body, nil
+}
+
+// cacheBaseURL は endpoint のリクエスト先となるベース URL を返します。
+func (c *Client) cacheBaseURL(endpoint string) string {
+	if endpoint == EndpointOtenkiASP {
+		return c.otenkiBaseURL
+	}
+	return c.baseURL
+}
@@ -1,8 +1,9 @@
 package api
 
 import (
-	"fmt"
 	"net/http"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
 )
 
 // APIError は API 通信に関連するエラーを表すカスタムエラー型です。
@@ -11,18 +12,24 @@ type APIError struct {
 	Body       string
 	Message    string
 	Err        error
+	Attempts   int // リトライを含む試行回数 (不明な場合は 0)
 }
 
 // Error は error インターフェースを実装し、APIError の内容に基づいたエラーメッセージ文字列を返します。
 // Message, Err, Body の順で利用可能な情報を使用してメッセージを構築します。
+// 複数回試行した場合は試行回数も含めます。
 func (e *APIError) Error() string {
+	status := i18n.Sprintf("ステータス: %d", e.StatusCode)
+	if e.Attempts > 1 {
+		status = i18n.Sprintf("%s, 試行回数: %d", status, e.Attempts)
+	}
 	if e.Message != "" {
-		return fmt.Sprintf("APIエラー: %s (ステータス: %d)", e.Message, e.StatusCode)
+		return i18n.Sprintf("APIエラー: %s (%s)", e.Message, status)
 	}
 	if e.Err != nil {
-		return fmt.Sprintf("APIエラー (ステータス: %d): %v", e.StatusCode, e.Err)
+		return i18n.Sprintf("APIエラー (%s): %v", status, e.Err)
 	}
-	return fmt.Sprintf("APIエラー (ステータス: %d): %s", e.StatusCode, e.Body)
+	return i18n.Sprintf("APIエラー (%s): %s", status, e.Body)
 }
 
 // newAPIError は新しい APIError インスタンスを作成するヘルパー関数です。
@@ -40,7 +47,7 @@ func newNotFoundError(resource string, identifier string) *APIError {
 	return newAPIError(
 		http.StatusNotFound,
 		"",
-		fmt.Sprintf("%s '%s' が見つかりません", resource, identifier),
+		i18n.Sprintf("%s '%s' が見つかりません", resource, identifier),
 		nil,
 	)
 }
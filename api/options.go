@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option は New に渡してクライアントの設定を変更するオプション関数です。
+type Option func(*Client)
+
+// WithBaseURL は zutool API のベースURLを設定します。
+// 空文字列の場合はデフォルト値のままになります。
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.baseURL = baseURL
+		}
+	}
+}
+
+// WithOtenkiBaseURL は Otenki ASP API のベースURLを設定します。
+// 空文字列の場合はデフォルト値のままになります。
+func WithOtenkiBaseURL(otenkiBaseURL string) Option {
+	return func(c *Client) {
+		if otenkiBaseURL != "" {
+			c.otenkiBaseURL = otenkiBaseURL
+		}
+	}
+}
+
+// WithTimeout はリクエストのタイムアウトを設定します。
+// ゼロの場合はデフォルト値 (WithHTTPClient 指定時はその設定) のままになります。
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithHTTPClient はリクエストに使用する http.Client を設定します。
+// 渡された http.Client は変更されず、WithTimeout や WithTransport の指定がある場合はコピーに適用されます。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport はリクエストに使用する http.RoundTripper を設定します。
+// プロキシ対応のトランスポートやテスト用のモックを差し込む際に使用します。
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithUserAgent は User-Agent ヘッダーの値を設定します。
+// 空文字列の場合はデフォルト値のままになります。
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		if userAgent != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
+// WithHeader は全リクエストに付与する追加ヘッダーを設定します。
+// 複数回指定した場合、同じキーの値は追加されます。User-Agent は WithUserAgent で設定してください。
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithRetryPolicy は失敗したリクエストの再試行方針を設定します。
+// デフォルトではリトライしません。CLI と同じ方針を使う場合は DefaultRetryPolicy を渡してください。
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
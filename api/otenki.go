@@ -0,0 +1,32 @@
+package api
+
+import (
+	"net/url"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// DefaultOtenkiASPDuration は Otenki ASP から取得するデフォルトの予報日数です。
+const DefaultOtenkiASPDuration = 7
+
+// OtenkiASPOptions は Otenki ASP から取得するコンテンツと期間の指定です。
+// ゼロ値はデフォルトのコンテンツ (models.DefaultOtenkiContentIDs) を7日分取得します。
+type OtenkiASPOptions struct {
+	ContentIDs []string   // 取得するコンテンツID (contents_id)。既知のIDは models.OtenkiContents を参照
+	Duration   int        // 予報日数 (duration_yohoushi)。0 の場合は DefaultOtenkiASPDuration
+	Params     url.Values // 時系列のコンテンツなどで必要な追加のクエリパラメータ。csid, contents_id, where などは上書きされます
+}
+
+func (o OtenkiASPOptions) contentIDs() []string {
+	if len(o.ContentIDs) == 0 {
+		return models.DefaultOtenkiContentIDs()
+	}
+	return o.ContentIDs
+}
+
+func (o OtenkiASPOptions) duration() int {
+	if o.Duration == 0 {
+		return DefaultOtenkiASPDuration
+	}
+	return o.Duration
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 )
 
@@ -25,7 +27,7 @@ func parseWeatherPointResponse(body []byte) (models.GetWeatherPointResponse, err
 			// HTTPリクエスト自体は成功している可能性があるため、ステータスコード200を使用
 			return finalResult, newAPIError(http.StatusOK, string(body), errorResponse.ErrorMessage, nil)
 		}
-		return finalResult, fmt.Errorf("/getweatherpoint の初期レスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
+		return finalResult, i18n.Errorf("/getweatherpoint の初期レスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
 	}
 
 	var weatherPoints models.WeatherPoints
@@ -33,7 +35,7 @@ func parseWeatherPointResponse(body []byte) (models.GetWeatherPointResponse, err
 	if errUnmarshalArray := json.Unmarshal([]byte(tempResult.Result), &points); errUnmarshalArray != nil {
 		// `{"result":"[]"}` のようなケースを正しく処理し、空のpointsスライスにする
 		if tempResult.Result != "[]" { // 空の配列文字列 "[]" は許可
-			return finalResult, fmt.Errorf("/getweatherpoint のネストされたresult文字列のアンマーシャルに失敗しました: %w, result string: %s", errUnmarshalArray, tempResult.Result)
+			return finalResult, i18n.Errorf("/getweatherpoint のネストされたresult文字列のアンマーシャルに失敗しました: %w, result string: %s", errUnmarshalArray, tempResult.Result)
 		}
 		// "[]" だった場合、pointsはnilまたは空になり、これは問題ない
 	}
@@ -52,7 +54,7 @@ func parseOtenkiASPResponse(body []byte) (models.GetOtenkiASPResponse, error) {
 			// APIエラー形式であれば、APIErrorを返す (ステータスコードは200とする)
 			return models.GetOtenkiASPResponse{}, newAPIError(http.StatusOK, string(body), errorResponse.ErrorMessage, nil)
 		}
-		return models.GetOtenkiASPResponse{}, fmt.Errorf("Otenki ASP 生レスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
+		return models.GetOtenkiASPResponse{}, i18n.Errorf("Otenki ASP 生レスポンスのアンマーシャルに失敗しました: %w, body: %s", err, string(body))
 	}
 
 	// 生レスポンスをより扱いやすい構造化レスポンス (GetOtenkiASPResponse) に変換
@@ -65,20 +67,20 @@ func parseOtenkiASPResponse(body []byte) (models.GetOtenkiASPResponse, error) {
 	for _, rawElem := range rawResponse.Body.Location.Element {
 		// 各要素はヘッダーレコードとデータレコードを持つ必要がある
 		if len(rawElem.Record) < 2 {
-			fmt.Printf("レコード数が不足しているため、生の要素をスキップします (ヘッダー + データが必要です): %+v\n", rawElem.Record)
+			fmt.Fprintf(os.Stderr, i18n.T("レコード数が不足しているため、生の要素をスキップします (ヘッダー + データが必要です): %+v\n"), rawElem.Record)
 			continue
 		}
 
 		headerRecord := rawElem.Record[0]
 		if len(headerRecord.Property) < 2 {
-			fmt.Printf("ヘッダープロパティの構造が無効なため、要素をスキップします (ContentID + Titleが必要です): %+v\n", headerRecord.Property)
+			fmt.Fprintf(os.Stderr, i18n.T("ヘッダープロパティの構造が無効なため、要素をスキップします (ContentID + Titleが必要です): %+v\n"), headerRecord.Property)
 			continue
 		}
 		headerProps := headerRecord.Property
 		contentID, okID := headerProps[0].(string)
 		title, okTitle := headerProps[1].(string)
 		if !okID || !okTitle {
-			fmt.Printf("ヘッダーのContentIDまたはTitleの型が無効なため、要素をスキップします: %+v\n", headerProps)
+			fmt.Fprintf(os.Stderr, i18n.T("ヘッダーのContentIDまたはTitleの型が無効なため、要素をスキップします: %+v\n"), headerProps)
 			continue
 		}
 
@@ -91,7 +93,7 @@ func parseOtenkiASPResponse(body []byte) (models.GetOtenkiASPResponse, error) {
 		for _, rawDataProperty := range rawElem.Record[1:] {
 			// 各データプロパティは時刻と値を持つ必要がある
 			if len(rawDataProperty.Property) < 2 {
-				fmt.Printf("データプロパティの構造が無効なため、スキップします (時刻 + 値が必要です): %+v\n", rawDataProperty.Property)
+				fmt.Fprintf(os.Stderr, i18n.T("データプロパティの構造が無効なため、スキップします (時刻 + 値が必要です): %+v\n"), rawDataProperty.Property)
 				continue
 			}
 			dataProps := rawDataProperty.Property
@@ -99,7 +101,7 @@ func parseOtenkiASPResponse(body []byte) (models.GetOtenkiASPResponse, error) {
 			value := dataProps[1]                   // 2番目が値のはず
 
 			if !okTime {
-				fmt.Printf("時刻が文字列でないデータレコードをスキップします: %v\n", dataProps[0])
+				fmt.Fprintf(os.Stderr, i18n.T("時刻が文字列でないデータレコードをスキップします: %v\n"), dataProps[0])
 				continue
 			}
 
@@ -118,7 +120,7 @@ func parseOtenkiASPResponse(body []byte) (models.GetOtenkiASPResponse, error) {
 			}
 
 			if !parsed {
-				fmt.Printf("時刻 '%s' をパースできないため、データレコードをスキップします: 試行したフォーマット %v\n", timeStr, formats)
+				fmt.Fprintf(os.Stderr, i18n.T("時刻 '%s' をパースできないため、データレコードをスキップします: 試行したフォーマット %v\n"), timeStr, formats)
 				continue
 			}
 			elem.Records[t] = value
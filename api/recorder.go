@@ -0,0 +1,238 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// Recording はディスクに保存された1組のリクエストとレスポンスです。
+// 1ファイルにつき1組を JSON 形式で保存し、ファイル名はメソッドとURLおよび同じURLへの何回目のリクエストかで決まります (SequencedRecordingFileName)。
+type Recording struct {
+	RecordedAt time.Time        `json:"recorded_at"`
+	Request    RecordedRequest  `json:"request"`
+	Response   RecordedResponse `json:"response"`
+}
+
+// RecordedRequest は記録されたリクエストです。
+// リクエストヘッダーは WithHeader で設定した認証情報などを含むことがあるため記録しません。
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// RecordedResponse は記録されたレスポンスです。
+// ボディが UTF-8 として正しい場合は Body に、そうでない場合は BodyBase64 にそのまま保存します。
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 []byte      `json:"body_base64,omitempty"`
+}
+
+// body はレスポンスボディの生のバイト列を返します。
+func (r RecordedResponse) body() []byte {
+	if r.BodyBase64 != nil {
+		return r.BodyBase64
+	}
+	return []byte(r.Body)
+}
+
+// RecordingFileName はメソッドとURLに対応する最初のリクエストの記録ファイル名 (例: "GET-0123456789abcdef.json") を返します。
+func RecordingFileName(method, rawURL string) string {
+	return SequencedRecordingFileName(method, rawURL, 1)
+}
+
+// SequencedRecordingFileName はメソッドとURLに対応する seq 回目 (1 から数える) のリクエストの記録ファイル名を返します。
+// 2回目以降のリクエスト (リトライを含む) は連番を付けたファイル (例: "GET-0123456789abcdef-2.json") に記録されます。
+func SequencedRecordingFileName(method, rawURL string, seq int) string {
+	sum := sha256.Sum256([]byte(method + " " + rawURL))
+	name := method + "-" + hex.EncodeToString(sum[:8])
+	if seq > 1 {
+		name += "-" + strconv.Itoa(seq)
+	}
+	return name + ".json"
+}
+
+// sequencer は記録ファイル名ごとのリクエストの回数を数えます。
+type sequencer struct {
+	mu    sync.Mutex
+	count map[string]int
+}
+
+// next は method と rawURL のリクエストの回数を1増やし、増やした後の回数を返します。
+// exists が nil でなく、増やした回数の記録が無い (exists が false を返す) 場合は回数を増やさずに返します。
+func (s *sequencer) next(method, rawURL string, exists func(seq int) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.count == nil {
+		s.count = make(map[string]int)
+	}
+	key := RecordingFileName(method, rawURL)
+	seq := s.count[key] + 1
+	if seq > 1 && exists != nil && !exists(seq) {
+		seq--
+	}
+	s.count[key] = seq
+	return seq
+}
+
+// ReadRecording は記録ファイルを読み込みます。記録されたボディをフィクスチャとして取り出す際などに使用します。
+func ReadRecording(path string) (*Recording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, i18n.Errorf("記録ファイルの読み込みに失敗しました: %w", err)
+	}
+	var rec Recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, i18n.Errorf("記録ファイル %s のアンマーシャルに失敗しました: %w", path, err)
+	}
+	return &rec, nil
+}
+
+// WithRecorder は全てのリクエストとレスポンスの組を dir に記録します。
+// 同じURLへのリクエストはリクエストした順に連番を付けたファイルに記録します (SequencedRecordingFileName)。
+// 同じ dir に再度記録すると、同じURLの前回の記録を全て削除してから記録し直します。
+// リクエストヘッダーは記録しません。
+// 奇妙なレスポンスを再現するためのバグ報告や、テスト用フィクスチャの作成に使用します。
+func WithRecorder(dir string) Option {
+	return func(c *Client) {
+		c.recordDir = dir
+	}
+}
+
+// WithReplay はネットワークに接続せず、WithRecorder で dir に記録したレスポンスを返します。
+// 同じURLへのリクエストには記録した順にレスポンスを返し、記録した回数を超えた場合は最後のレスポンスを繰り返します。
+// 記録が無いリクエストはエラーになります。
+func WithReplay(dir string) Option {
+	return func(c *Client) {
+		c.replayDir = dir
+	}
+}
+
+// recordingTransport は next で実行したリクエストとレスポンスを dir に書き出す http.RoundTripper です。
+type recordingTransport struct {
+	dir  string
+	next http.RoundTripper
+	seq  sequencer
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, i18n.Errorf("記録のためのレスポンスボディの読み込みに失敗しました: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	rec := Recording{
+		RecordedAt: time.Now(),
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+		},
+	}
+	if utf8.Valid(body) {
+		rec.Response.Body = string(body)
+	} else {
+		rec.Response.BodyBase64 = body
+	}
+	if err := t.write(rec, t.seq.next(req.Method, req.URL.String(), nil)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *recordingTransport) write(rec Recording, seq int) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return i18n.Errorf("記録のマーシャリングに失敗しました: %w", err)
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return i18n.Errorf("記録ディレクトリの作成に失敗しました: %w", err)
+	}
+	if seq == 1 {
+		// 前回の記録の2回目以降が残っていると再生時に今回の記録の後に返されてしまうため削除する
+		if err := t.removeStale(rec.Request.Method, rec.Request.URL); err != nil {
+			return err
+		}
+	}
+	path := filepath.Join(t.dir, SequencedRecordingFileName(rec.Request.Method, rec.Request.URL, seq))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return i18n.Errorf("記録ファイルの書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// removeStale は method と rawURL の前回の記録のうち、2回目以降のファイルを削除します。
+func (t *recordingTransport) removeStale(method, rawURL string) error {
+	for seq := 2; ; seq++ {
+		err := os.Remove(filepath.Join(t.dir, SequencedRecordingFileName(method, rawURL, seq)))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return i18n.Errorf("前回の記録ファイルの削除に失敗しました: %w", err)
+		}
+	}
+}
+
+// replayTransport は dir に記録されたレスポンスを返す http.RoundTripper です。
+type replayTransport struct {
+	dir string
+	seq sequencer
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	pathOf := func(seq int) string {
+		return filepath.Join(t.dir, SequencedRecordingFileName(req.Method, req.URL.String(), seq))
+	}
+	// 記録した回数を超えた場合は最後のレスポンスを繰り返す
+	seq := t.seq.next(req.Method, req.URL.String(), func(seq int) bool {
+		_, err := os.Stat(pathOf(seq))
+		return err == nil
+	})
+	rec, err := ReadRecording(pathOf(seq))
+	if err != nil {
+		return nil, i18n.Errorf("記録されたレスポンスがありません: %w", err)
+	}
+
+	body := rec.Response.body()
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.Response.StatusCode, http.StatusText(rec.Response.StatusCode)),
+		StatusCode:    rec.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/api/apitest"
+)
+
+// TestRecordAndReplay は記録したレスポンスがネットワーク無しで再生できることを確認します。
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := apitest.NewServer(t)
+
+	recorder := server.Client(api.WithRecorder(dir))
+	want, err := recorder.GetWeatherStatus("13113")
+	if err != nil {
+		t.Fatalf("記録中の client.GetWeatherStatus が失敗しました: %v", err)
+	}
+	if _, err := recorder.GetWeatherPoint("神戸市"); err != nil {
+		t.Fatalf("記録中の client.GetWeatherPoint が失敗しました: %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 2 {
+		t.Fatalf("記録ファイルが %d 個作成されました。期待値: 2", len(files))
+	}
+	rec, err := api.ReadRecording(filepath.Join(dir, api.RecordingFileName("GET", server.BaseURL()+"/getweatherstatus/13113")))
+	if err != nil {
+		t.Fatalf("記録ファイルの読み込みに失敗しました: %v", err)
+	}
+	fixture, _ := apitest.Fixture("getweatherstatus/13113.json")
+	if rec.Response.StatusCode != 200 || rec.Response.Body != string(fixture) {
+		t.Errorf("記録されたレスポンスが一致しません: ステータス %d, ボディ長 %d", rec.Response.StatusCode, len(rec.Response.Body))
+	}
+
+	server.Close() // 再生時はネットワークに接続しないことを確認するためサーバーを停止
+	replayer := api.New(api.WithBaseURL(server.BaseURL()), api.WithReplay(dir))
+	got, err := replayer.GetWeatherStatus("13113")
+	if err != nil {
+		t.Fatalf("再生中の client.GetWeatherStatus が失敗しました: %v", err)
+	}
+	if got.PlaceName != want.PlaceName || len(got.Today) != len(want.Today) {
+		t.Errorf("再生されたレスポンスが記録時と異なります: %q (%d件), 期待値: %q (%d件)", got.PlaceName, len(got.Today), want.PlaceName, len(want.Today))
+	}
+	points, err := replayer.GetWeatherPoint("神戸市")
+	if err != nil || len(points.Result.Root) == 0 {
+		t.Errorf("再生中の client.GetWeatherPoint が失敗しました: %v (%d件)", err, len(points.Result.Root))
+	}
+}
+
+// TestRecordSequence は同じURLへの複数のリクエストが全て記録され、再生時に記録した順に返されることを確認します。
+func TestRecordSequence(t *testing.T) {
+	dir := t.TempDir()
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"painnoterate_status":{"area_name":"地域%d","time_start":"12","time_end":"17"}}`, calls)
+	}))
+	recorder := api.New(api.WithBaseURL(server.URL), api.WithRecorder(dir))
+	for i := 0; i < 2; i++ {
+		if _, err := recorder.GetPainStatus("13", nil); err != nil {
+			t.Fatalf("記録中の client.GetPainStatus が失敗しました: %v", err)
+		}
+	}
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 2 {
+		t.Fatalf("記録ファイルが %d 個作成されました。期待値: 2", len(files))
+	}
+
+	replayer := api.New(api.WithBaseURL(server.URL), api.WithReplay(dir))
+	for i, want := range []string{"地域1", "地域2", "地域2"} {
+		res, err := replayer.GetPainStatus("13", nil)
+		if err != nil {
+			t.Fatalf("再生中の client.GetPainStatus (%d回目) が失敗しました: %v", i+1, err)
+		}
+		if got := res.PainnoterateStatus.AreaName; got != want {
+			t.Errorf("%d回目の再生で %q が返されました。期待値: %q (記録した順に返し、最後のレスポンスを繰り返すはずです)", i+1, got, want)
+		}
+	}
+}
+
+// TestReplayMissing は記録が無いリクエストがエラーになることを確認します。
+func TestReplayMissing(t *testing.T) {
+	client := api.New(api.WithBaseURL("http://example.invalid/api"), api.WithReplay(t.TempDir()))
+	if _, err := client.GetPainStatus("13", nil); err == nil {
+		t.Error("記録が無い場合は失敗するはずですが、nil エラーが返されました")
+	}
+}
+
+// TestRecordErrorResponse はエラーレスポンスも記録され、再生時に同じエラーになることを確認します。
+func TestRecordErrorResponse(t *testing.T) {
+	dir := t.TempDir()
+	server := apitest.NewServer(t)
+	if _, err := server.Client(api.WithRecorder(dir)).GetOtenkiASP("13000"); err == nil {
+		t.Fatal("記録中の client.GetOtenkiASP(\"13000\") は失敗するはずですが、nil エラーが返されました")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Fatalf("記録ファイルが %d 個作成されました。期待値: 1", len(entries))
+	}
+
+	server.Close()
+	_, err := api.New(api.WithOtenkiBaseURL(server.OtenkiBaseURL()), api.WithReplay(dir)).GetOtenkiASP("13000")
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("再生時に 404 の APIError を期待しましたが、%v が返されました", err)
+	}
+}
+
+// TestRecordOverwrite は同じディレクトリに記録し直すと前回の余分な記録が残らず、リクエストヘッダーが記録されないことを確認します。
+func TestRecordOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"painnoterate_status":{"area_name":"地域%d","time_start":"12","time_end":"17"}}`, calls)
+	}))
+	defer server.Close()
+
+	for _, n := range []int{3, 1} {
+		recorder := api.New(api.WithBaseURL(server.URL), api.WithRecorder(dir), api.WithHeader("Authorization", "Bearer secret"))
+		for i := 0; i < n; i++ {
+			if _, err := recorder.GetPainStatus("13", nil); err != nil {
+				t.Fatalf("記録中の client.GetPainStatus が失敗しました: %v", err)
+			}
+		}
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("記録ファイルが %d 個残っています。期待値: 1 (前回の2回目以降の記録は削除されるはずです)", len(files))
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("記録ファイルの読み込みに失敗しました: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("記録ファイルにリクエストヘッダーが含まれています: %s", data)
+	}
+
+	res, err := api.New(api.WithBaseURL(server.URL), api.WithReplay(dir)).GetPainStatus("13", nil)
+	if err != nil {
+		t.Fatalf("再生中の client.GetPainStatus が失敗しました: %v", err)
+	}
+	if got := res.PainnoterateStatus.AreaName; got != "地域4" {
+		t.Errorf("再生で %q が返されました。期待値: %q (今回の記録)", got, "地域4")
+	}
+}
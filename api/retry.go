@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy は doRequest が失敗したリクエストを再試行する際の方針を表します。
+// ゼロ値はリトライしない (1回のみ試行する) ことを意味します。
+type RetryPolicy struct {
+	MaxAttempts          int           // 最大試行回数 (初回を含む)。1 以下の場合はリトライしない
+	InitialBackoff       time.Duration // 1回目のリトライまでの待機時間
+	MaxBackoff           time.Duration // 待機時間の上限 (Retry-After ヘッダーの値にも適用)。ゼロの場合は上限なし
+	Multiplier           float64       // リトライごとに待機時間に掛ける係数。1 未満の場合は 1 として扱う
+	Jitter               float64       // 待機時間に加える揺らぎの割合 (0〜1)。0.2 なら ±20%
+	RetryableStatusCodes []int         // リトライ対象とするHTTPステータスコード
+}
+
+// DefaultRetryPolicy は zutool.jp の一時的な 5xx やタイムアウトを想定したリトライ方針を返します。
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// maxAttempts は実際に使用する最大試行回数を返します。
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// retryableStatus は statusCode がリトライ対象かどうかを返します。
+func (p RetryPolicy) retryableStatus(statusCode int) bool {
+	return slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+// backoff は attempt 回目の試行が失敗した後の待機時間を計算します。
+// retryAfter が正の場合は指数バックオフの代わりにその値を使用します。
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	wait := retryAfter
+	if wait <= 0 {
+		multiplier := p.Multiplier
+		if multiplier < 1 {
+			multiplier = 1
+		}
+		w := float64(p.InitialBackoff)
+		for i := 1; i < attempt; i++ {
+			w *= multiplier
+		}
+		if p.Jitter > 0 {
+			w *= 1 + p.Jitter*(2*rand.Float64()-1)
+		}
+		wait = time.Duration(w)
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// parseRetryAfter は Retry-After ヘッダーの値 (秒数またはHTTP日付) を待機時間に変換します。
+// ヘッダーが無い、または解釈できない場合はゼロを返します。
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// sleepContext は d だけ待機します。ctx が先に終了した場合はそのエラーを返します。
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -1,27 +1,116 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/cache"
 	"github.com/eraiza0816/zu2l/internal/commands"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/notify"
 	"github.com/eraiza0816/zu2l/internal/presenter"
 )
 
 func main() {
-	// APIクライアントを一度だけインスタンス化
-	// 現状はURLとタイムアウトにデフォルト値を使用
-	// TODO: 将来的にフラグや設定ファイルで設定可能にすることを検討
-	apiClient := api.NewClient("", "", 0)
-
-	// フラグに基づいて適切なプレゼンターを作成するヘルパー関数
-	getPresenter := func(cmd *cobra.Command) presenter.Presenter {
-		jsonOutput, _ := cmd.Flags().GetBool("json")
-		if jsonOutput {
-			return &presenter.JSONPresenter{Writer: os.Stdout}
+	// 言語は --lang > 環境変数 ZUTOOL_LANG > 設定ファイルの lang > 環境変数 LANG など の優先順位で決まる。
+	// 設定を読み込むまではロケールの環境変数から判定した言語を使う
+	i18n.SetLang(i18n.Detect(os.Getenv))
+	// APIクライアントはフラグのパース後に PersistentPreRunE で一度だけインスタンス化する
+	var apiClient *api.Client
+	// 設定は フラグ > 環境変数 > 設定ファイル > デフォルト値 の優先順位で PersistentPreRunE で読み込む
+	var cfg *config.Config
+	var cfgPath string
+
+	// loadConfig は設定ファイルと環境変数を読み込み、明示的に指定されたフラグで上書きするヘルパー関数
+	loadConfig := func(cmd *cobra.Command) error {
+		cfgPath, _ = cmd.Flags().GetString("config")
+		if cfgPath == "" {
+			path, err := config.DefaultPath()
+			if err != nil {
+				return err
+			}
+			cfgPath = path
+		}
+		loaded, err := config.Load(cfgPath)
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("base-url") {
+			loaded.BaseURL, _ = cmd.Flags().GetString("base-url")
+		}
+		if cmd.Flags().Changed("otenki-base-url") {
+			loaded.OtenkiBaseURL, _ = cmd.Flags().GetString("otenki-base-url")
+		}
+		if cmd.Flags().Changed("timeout") {
+			loaded.Timeout, _ = cmd.Flags().GetDuration("timeout")
+		}
+		if cmd.Flags().Changed("template") {
+			// --template のみ指定された場合は --format template とみなす
+			loaded.Template, _ = cmd.Flags().GetString("template")
+			if !cmd.Flags().Changed("format") && !cmd.Flags().Changed("json") {
+				loaded.Format = "template"
+			}
+		}
+		for _, key := range []string{"color", "theme", "lang"} {
+			if cmd.Flags().Changed(key) {
+				value, _ := cmd.Flags().GetString(key)
+				if err := loaded.Set(key, value); err != nil {
+					return err
+				}
+			}
+		}
+		if cmd.Flags().Changed("format") {
+			format, _ := cmd.Flags().GetString("format")
+			if err := loaded.Set("format", format); err != nil {
+				return err
+			}
+		} else if cmd.Flags().Changed("json") {
+			// 非推奨の --json は --format json (--json=false の場合は table) として扱う
+			loaded.Format = "table"
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				loaded.Format = "json"
+			}
 		}
-		return &presenter.TablePresenter{Writer: os.Stdout}
+		if loaded.Lang != "" {
+			i18n.SetLang(i18n.Lang(loaded.Lang))
+		}
+		cfg = loaded
+		return nil
+	}
+
+	// newCacheStore は設定とフラグに基づいてキャッシュストアを作成するヘルパー関数
+	newCacheStore := func(cmd *cobra.Command) (*cache.Store, error) {
+		dir := cfg.Cache.Dir
+		if dir == "" {
+			defaultDir, err := cache.DefaultDir()
+			if err != nil {
+				return nil, err
+			}
+			dir = defaultDir
+		}
+		store := cache.New(dir)
+		for endpoint, ttl := range cfg.Cache.TTL {
+			store.TTL[endpoint] = ttl
+		}
+		store.Refresh, _ = cmd.Flags().GetBool("refresh")
+		return store, nil
+	}
+
+	// 設定の format (--format で上書き済み) に基づいて適切なプレゼンターを作成するヘルパー関数
+	getPresenter := func(cmd *cobra.Command) (presenter.Presenter, error) {
+		return presenter.New(cfg.Format, os.Stdout,
+			presenter.WithTemplate(cfg.Template), presenter.WithColor(cfg.Color), presenter.WithTheme(cfg.Theme))
 	}
 
 	rootCmd := &cobra.Command{
@@ -31,20 +120,57 @@ func main() {
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.Help()
 		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := loadConfig(cmd); err != nil {
+				return err
+			}
+			opts := []api.Option{
+				api.WithBaseURL(cfg.BaseURL),
+				api.WithOtenkiBaseURL(cfg.OtenkiBaseURL),
+				api.WithTimeout(cfg.Timeout),
+			}
+			// 記録・再生時は全てのリクエストが実際に doRequest を通るようキャッシュを使用しない
+			recordDir, _ := cmd.Flags().GetString("record")
+			replayDir, _ := cmd.Flags().GetString("replay")
+			useCache := recordDir == "" && replayDir == ""
+			switch {
+			case recordDir != "":
+				opts = append(opts, api.WithRecorder(recordDir), api.WithRetryPolicy(cfg.RetryPolicy()))
+			case replayDir != "":
+				// 再生はネットワークに接続しないため、記録が無い場合にリトライしても結果は変わらない
+				opts = append(opts, api.WithReplay(replayDir))
+			default:
+				opts = append(opts, api.WithRetryPolicy(cfg.RetryPolicy()))
+			}
+			if noCache, _ := cmd.Flags().GetBool("no-cache"); useCache && !noCache && cfg.Cache.IsEnabled() {
+				store, err := newCacheStore(cmd)
+				if err != nil {
+					// キャッシュが使えなくてもAPIの取得自体は可能なため、警告のみとする
+					fmt.Fprintf(os.Stderr, i18n.T("警告: キャッシュを使用せずに実行します: %v\n"), err)
+				} else {
+					opts = append(opts, api.WithCache(store))
+				}
+			}
+			apiClient = api.New(opts...)
+			return nil
+		},
 	}
 
 	painStatusCommand := &cobra.Command{
-		Use:     "pain_status [area_code]",
+		Use:     "pain_status [area_code|@location]",
 		Aliases: []string{"ps"},
 		Short:   "都道府県別の痛み予報を取得します",
-		Long:    "指定された都道府県コードの痛み予報を取得して表示します。",
-		Args:    cobra.ExactArgs(1),
+		Long:    "指定された都道府県コードの痛み予報を取得して表示します。@name で保存済みの地点を指定でき、省略時は設定の default_area またはデフォルトの地点を使用します。",
+		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pres := getPresenter(cmd)
-			return commands.RunPainStatus(apiClient, pres, cmd, args)
+			pres, err := getPresenter(cmd)
+			if err != nil {
+				return err
+			}
+			return commands.RunPainStatus(apiClient, pres, cfg, cmd, args)
 		},
 	}
-	painStatusCommand.Flags().StringP("set_weather_point", "s", "", "地点コード (例: '13113') を指定して地域固有の予報を取得")
+	painStatusCommand.Flags().StringP("set_weather_point", "s", "", "地点コード (例: '13113')、地名 (例: 渋谷) または @name を指定して地域固有の予報を取得")
 	rootCmd.AddCommand(painStatusCommand)
 
 	weatherPointCommand := &cobra.Command{
@@ -54,7 +180,10 @@ func main() {
 		Long:    "指定されたキーワード (例: 都市名) に基づいて気象観測地点を検索します。",
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pres := getPresenter(cmd)
+			pres, err := getPresenter(cmd)
+			if err != nil {
+				return err
+			}
 			// RunWeatherPoint が --kata フラグにアクセスできるように cmd を渡す
 			return commands.RunWeatherPoint(apiClient, pres, cmd, args)
 		},
@@ -63,38 +192,245 @@ func main() {
 	rootCmd.AddCommand(weatherPointCommand)
 
 	weatherStatusCommand := &cobra.Command{
-		Use:     "weather_status [city_code]",
+		Use:     "weather_status [city_code|地名|@location]",
 		Aliases: []string{"ws"},
 		Short:   "都市別の詳細な気象状況を取得します",
-		Long:    "指定された都市コードの詳細な気象状況 (気温、気圧など) を取得して表示します。",
-		Args:    cobra.ExactArgs(1),
+		Long:    "指定された都市コードの詳細な気象状況 (気温、気圧など) を取得して表示します。@name で保存済みの地点を指定でき、省略時は設定の default_city またはデフォルトの地点を使用します。",
+		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pres := getPresenter(cmd)
+			pres, err := getPresenter(cmd)
+			if err != nil {
+				return err
+			}
 			// RunWeatherStatus が --n フラグにアクセスできるように cmd を渡す
-			return commands.RunWeatherStatus(apiClient, pres, cmd, args)
+			return commands.RunWeatherStatus(apiClient, pres, cfg, cmd, args)
 		},
 	}
 	weatherStatusCommand.Flags().IntSliceP("n", "n", []int{0}, "表示する日のオフセット番号 (-1 から 2) を指定 (複数指定可)")
+	weatherStatusCommand.Flags().Bool("graph", false, "表の代わりに気圧の推移をグラフで表示する (端末の幅に合わせて描画し、端末以外への出力では ASCII 文字を使用)")
+	weatherStatusCommand.Flags().Bool("temp", false, "--graph のグラフに気温も重ねて表示する")
+	weatherStatusCommand.Flags().String("export", "", "表の代わりに気圧グラフを画像として保存するファイル (拡張子 .svg または .png で形式を指定)")
+	weatherStatusCommand.MarkFlagsMutuallyExclusive("graph", "export")
 	rootCmd.AddCommand(weatherStatusCommand)
 
+	pressureAnalysisCommand := &cobra.Command{
+		Use:     "pressure_analysis [city_code|地名|@location]",
+		Aliases: []string{"pa"},
+		Short:   "気圧変化を分析し、頭痛に注意が必要な時間帯を表示します",
+		Long:    "指定された都市コードの気圧予報から、1時間ごとの気圧変化量、3/6/24時間の変化量、最も急な気圧低下と、気圧レベルまたは急な気圧低下によるリスク時間帯を計算して表示します。地点の指定方法は weather_status と同じです。",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pres, err := getPresenter(cmd)
+			if err != nil {
+				return err
+			}
+			return commands.RunPressureAnalysis(apiClient, pres, cfg, cmd, args)
+		},
+	}
+	pressureAnalysisCommand.Flags().IntSliceP("n", "n", []int{0, 1, 2}, "分析結果に含める日のオフセット番号 (-1 から 2) を指定 (複数指定可)")
+	pressureAnalysisCommand.Flags().Int("window", analysis.DefaultDropWindow, "最も急な気圧低下を探す期間 (時間)")
+	pressureAnalysisCommand.Flags().Float64("threshold", analysis.DefaultDropThreshold, "リスク時間帯とみなす3時間あたりの気圧低下量 (hPa)")
+	pressureAnalysisCommand.Flags().String("level", string(analysis.DefaultRiskLevel), "リスク時間帯とみなす気圧レベル (0, 2, 3, 4, 5 のいずれか。この値以上)")
+	pressureAnalysisCommand.Flags().Bool("hourly", false, "1時間ごとの気圧変化量のテーブルも表示する")
+	rootCmd.AddCommand(pressureAnalysisCommand)
+
+	watchCommand := &cobra.Command{
+		Use:   "watch [name...]",
+		Short: "気圧レベルと痛み予報を定期的に確認し、警報を通知します",
+		Long:  "保存済みの地点 (省略時は全地点) の気圧予報と痛み予報を --interval ごとに取得し、--within 以内に気圧レベルが --level 以上になる場合や、痛み予報の割合が閾値以上の場合に --sink の通知先へ通知します。同じ警報は1回だけ通知されます。Ctrl-C で終了します。",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunWatch(apiClient, cfg, cmd, args)
+		},
+	}
+	watchCommand.Flags().Duration("interval", 30*time.Minute, "予報を取得する間隔")
+	watchCommand.Flags().Duration("within", notify.DefaultRules.Within, "現在時刻からこの期間内の気圧予報を対象にする")
+	watchCommand.Flags().String("level", string(notify.DefaultRules.Level), "通知する気圧レベル (0, 2, 3, 4, 5 のいずれか。この値以上)")
+	watchCommand.Flags().Float64("painful", notify.DefaultRules.PainfulRate, "「痛い」以上の割合 (%) がこの値以上で通知する (0 で無効)")
+	watchCommand.Flags().Float64("bad", notify.DefaultRules.BadRate, "「かなり痛い」の割合 (%) がこの値以上で重要な通知をする (0 で無効)")
+	watchCommand.Flags().StringArray("sink", []string{"stdout"}, "通知先 ("+strings.Join(notify.SinkSpecs, ", ")+")。複数指定可")
+	watchCommand.Flags().String("state", "", "通知済みの警報を保存するファイル (再起動後も同じ警報を通知しない)")
+	watchCommand.Flags().Bool("once", false, "1回だけ確認して終了する (cron などからの実行用)")
+	rootCmd.AddCommand(watchCommand)
+
+	notifyCommand := &cobra.Command{
+		Use:   "notify [city_code|地名|@location]",
+		Short: "頭痛予報を Slack, Discord, Teams の Webhook に投稿します",
+		Long:  "指定された地点の痛み予報・気圧予報・週間予報 (--content) をまとめ、--sink の形式 (" + strings.Join(notify.ChatFormatNames(), ", ") + ") で --url の Webhook に投稿します。@name で保存済みの地点を指定でき、省略時は設定の default_city またはデフォルトの地点を使用します。",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunNotify(apiClient, cfg, cmd, args)
+		},
+	}
+	notifyCommand.Flags().String("sink", "", "投稿先の形式 ("+strings.Join(notify.ChatFormatNames(), ", ")+")")
+	notifyCommand.Flags().String("url", "", "Webhook の URL")
+	notifyCommand.Flags().StringSlice("content", []string{"pain", "weather"}, "投稿する内容 ("+strings.Join(commands.NotifyContents, ", ")+")。forecast は Otenki ASP が対応している都市のみ")
+	notifyCommand.Flags().Int("day", 0, "気圧予報の日のオフセット番号 (-1 から 2)")
+	notifyCommand.Flags().Bool("dry-run", false, "投稿せずにペイロードの JSON を出力する")
+	notifyCommand.MarkFlagRequired("sink")
+	rootCmd.AddCommand(notifyCommand)
+
 	otenkiAspCommand := &cobra.Command{
-		Use:     "otenki_asp [city_code]",
+		Use:     "otenki_asp [city_code|地名|@location]",
 		Aliases: []string{"oa"},
 		Short:   "Otenki ASP から気象情報を取得します",
-		Long:    "特定の主要都市コードについて、Otenki ASP サービスから様々な天気予報要素 (天気、気温、風など) を取得します。",
-		Args:    cobra.ExactArgs(1),
+		Long:    "特定の主要都市コードについて、Otenki ASP サービスから様々な天気予報要素 (天気、気温、風など) を取得します。@name で保存済みの地点を指定でき、省略時は設定の default_city またはデフォルトの地点を使用します。",
+		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pres := getPresenter(cmd)
+			pres, err := getPresenter(cmd)
+			if err != nil {
+				return err
+			}
 			// RunOtenkiAsp が --n フラグにアクセスできるように cmd を渡す
-			return commands.RunOtenkiAsp(apiClient, pres, cmd, args)
+			return commands.RunOtenkiAsp(apiClient, pres, cfg, cmd, args)
 		},
 	}
-	otenkiAspCommand.Flags().IntSliceP("n", "n", []int{0, 1, 2, 3, 4, 5, 6}, "表示する予報日のオフセット番号 (0 から 6) を指定 (複数指定可)")
+	otenkiAspCommand.Flags().IntSliceP("n", "n", []int{0, 1, 2, 3, 4, 5, 6}, "表示する予報日のオフセット番号 (0 から 予報日数-1) を指定 (複数指定可、省略時は全日)")
+	otenkiAspCommand.Flags().StringSlice("contents", models.DefaultOtenkiContentIDs(), "取得するコンテンツID (カンマ区切り)。既知のID: "+strings.Join(models.DefaultOtenkiContentIDs(), ", "))
+	otenkiAspCommand.Flags().Int("duration", api.DefaultOtenkiASPDuration, "取得する予報日数")
 	rootCmd.AddCommand(otenkiAspCommand)
 
+	cacheCommand := &cobra.Command{
+		Use:   "cache",
+		Short: "レスポンスのキャッシュを管理します",
+		Long:  "API レスポンスのディスクキャッシュ (XDG キャッシュディレクトリ配下) を削除したり統計情報を表示したりします。",
+	}
+	cacheCommand.AddCommand(&cobra.Command{
+		Use:   "clear",
+		Short: "キャッシュを全て削除します",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := newCacheStore(cmd)
+			if err != nil {
+				return err
+			}
+			return commands.RunCacheClear(store, cmd, args)
+		},
+	})
+	cacheCommand.AddCommand(&cobra.Command{
+		Use:   "stats",
+		Short: "キャッシュの統計情報を表示します",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := newCacheStore(cmd)
+			if err != nil {
+				return err
+			}
+			return commands.RunCacheStats(store, cmd, args)
+		},
+	})
+	rootCmd.AddCommand(cacheCommand)
+
+	configCommand := &cobra.Command{
+		Use:   "config",
+		Short: "設定を表示・変更します",
+		Long: "設定ファイル (XDG_CONFIG_HOME/zutool/config.yaml または ZUTOOL_CONFIG) を表示・変更します。\n" +
+			"各設定は ZUTOOL_TIMEOUT や ZUTOOL_RETRY_MAX_ATTEMPTS のような環境変数でも上書きできます。\n" +
+			"優先順位は フラグ > 環境変数 > 設定ファイル > デフォルト値 です。",
+	}
+	configCommand.AddCommand(&cobra.Command{
+		Use:   "show",
+		Short: "実際に使用される設定を表示します",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunConfigShow(cfg, cmd, args)
+		},
+	})
+	configCommand.AddCommand(&cobra.Command{
+		Use:     "set <key> <value>",
+		Short:   "設定ファイルの値を変更します",
+		Long:    "設定ファイルの値を変更します。有効なキー: " + strings.Join(config.Keys(), ", "),
+		Example: "  zutool config set timeout 20s\n  zutool config set default_city 13113\n  zutool config set cache.ttl.getpainstatus 5m",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunConfigSet(cfgPath, cmd, args)
+		},
+	})
+	configCommand.AddCommand(&cobra.Command{
+		Use:   "path",
+		Short: "設定ファイルのパスを表示します",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunConfigPath(cfgPath, cmd, args)
+		},
+	})
+	rootCmd.AddCommand(configCommand)
+
+	locationCommand := &cobra.Command{
+		Use:     "location",
+		Aliases: []string{"loc"},
+		Short:   "名前付きの地点を管理します",
+		Long: "よく使う地点コードに名前を付けて設定ファイルに保存します。\n" +
+			"保存した地点は pain_status, weather_status, otenki_asp で @name として指定できます。",
+	}
+	locationAddCommand := &cobra.Command{
+		Use:     "add <name>",
+		Short:   "地点を登録します",
+		Example: "  zutool location add home --city 13113\n  zutool location add office --city 27128 --default",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunLocationAdd(cfgPath, cmd, args)
+		},
+	}
+	locationAddCommand.Flags().String("city", "", "地点コード (例: '13113')")
+	locationAddCommand.Flags().String("area", "", "痛み予報の地域コード (省略時は地点コードの先頭2桁)")
+	locationAddCommand.Flags().Bool("default", false, "登録した地点をデフォルトの地点にする")
+	locationAddCommand.MarkFlagRequired("city")
+	locationCommand.AddCommand(locationAddCommand)
+	locationCommand.AddCommand(&cobra.Command{
+		Use:     "remove <name>",
+		Aliases: []string{"rm"},
+		Short:   "地点を削除します",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunLocationRemove(cfgPath, cmd, args)
+		},
+	})
+	locationCommand.AddCommand(&cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "登録済みの地点を一覧表示します",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunLocationList(cfg, cmd, args)
+		},
+	})
+	locationCommand.AddCommand(&cobra.Command{
+		Use:   "default [name]",
+		Short: "引数を省略した場合に使用する地点を設定・表示します",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunLocationDefault(cfgPath, cmd, args)
+		},
+	})
+	rootCmd.AddCommand(locationCommand)
+
+	rootCmd.PersistentFlags().StringP("format", "f", "", "出力形式 ("+strings.Join(presenter.Formats, ", ")+"、デフォルト: 設定の format または table)")
+	rootCmd.PersistentFlags().String("template", "", "--format template で使用する Go テンプレートのファイルまたは文字列 (例: '{{.PlaceName}} {{range .Today}}{{emoji .Weather}}{{end}}')")
+	rootCmd.PersistentFlags().String("color", "", "table 形式の出力に色を付けるか ("+strings.Join(presenter.ColorModes, ", ")+"、デフォルト: 設定の color または auto。auto は端末への出力で環境変数 NO_COLOR が無い場合に色を付ける)")
+	rootCmd.PersistentFlags().String("theme", "", "table 形式の出力の色のテーマ ("+strings.Join(presenter.ThemeNames(), ", ")+"、デフォルト: 設定の theme または default)")
+	rootCmd.PersistentFlags().String("lang", "", "表示する言語 ("+strings.Join(i18n.Langs, ", ")+"、デフォルト: 設定の lang または環境変数 LC_ALL、LC_MESSAGES、LANG から判定)")
 	rootCmd.PersistentFlags().BoolP("json", "j", false, "結果をJSON形式で出力する")
+	rootCmd.PersistentFlags().MarkDeprecated("json", "--format json を使用してください")
+	rootCmd.MarkFlagsMutuallyExclusive("format", "json")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "レスポンスのキャッシュを使用しない")
+	rootCmd.PersistentFlags().Bool("refresh", false, "キャッシュを無視して再取得し、キャッシュを更新する")
+	rootCmd.PersistentFlags().String("config", "", "設定ファイルのパス (デフォルト: XDG_CONFIG_HOME/zutool/config.yaml)")
+	rootCmd.PersistentFlags().String("base-url", api.DefaultBaseURL, "zutool API のベースURL")
+	rootCmd.PersistentFlags().String("otenki-base-url", api.DefaultOtenkiASPBaseURL, "Otenki ASP API のベースURL")
+	rootCmd.PersistentFlags().Duration("timeout", api.DefaultTimeout, "API リクエストのタイムアウト")
+	rootCmd.PersistentFlags().String("record", "", "全ての API リクエストとレスポンスを指定したディレクトリに記録する (同じURLへのリクエストは連番を付けて全て記録し、同じURLの前回の記録は削除する。リクエストヘッダーは記録しない)")
+	rootCmd.PersistentFlags().String("replay", "", "ネットワークに接続せず、--record で記録したディレクトリのレスポンスを記録した順に再生する")
+	rootCmd.MarkFlagsMutuallyExclusive("record", "replay")
 
-	if err := rootCmd.Execute(); err != nil {
+	// Ctrl-C (SIGINT) や SIGTERM を受け取ったら実行中のAPI呼び出しをキャンセルする
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			fmt.Fprintln(os.Stderr, i18n.T("中断されました"))
+			os.Exit(130)
+		}
 		os.Exit(1)
 	}
 }
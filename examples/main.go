@@ -28,12 +28,12 @@ func main() {
 		for _, status := range weatherStatus.Today {
 			tempStr := "N/A"
 			if status.Temp != nil {
-				tempStr = *status.Temp + "°C" // Assuming Temp is a string like "15.3"
+				tempStr = fmt.Sprintf("%.1f°C", *status.Temp) // Temp is *float64 (nil when unavailable)
 			}
-			fmt.Printf("  Time: %s, Temp: %s, Pressure: %s, Weather: %s, PressureLevel: %s\n",
-				status.Time, // Use Time (string)
-				tempStr,     // Use Temp (*string) with nil check
-				status.Pressure,
+			fmt.Printf("  Time: %s, Temp: %s, Pressure: %.1f hPa, Weather: %s, PressureLevel: %s\n",
+				status.At.Format("01/02 15:04"), // Absolute time in JST (Hour holds the hour as int)
+				tempStr,
+				status.Pressure, // Pressure is float64
 				status.Weather.String(), // Use WeatherEnum.String()
 				status.PressureLevel.String(), // Add PressureLevel
 			)
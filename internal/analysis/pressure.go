@@ -0,0 +1,237 @@
+// Package analysis は気圧予報から頭痛の要因となる気圧変化を分析するドメインサービスを提供します。
+package analysis
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+const (
+	// DefaultDropWindow は最も急な気圧低下を探す期間 (時間) のデフォルト値です。
+	DefaultDropWindow = 3
+	// DefaultDropThreshold はリスク時間帯とみなす3時間あたりの気圧低下量 (hPa) のデフォルト値です。
+	DefaultDropThreshold = 1.0
+	// DefaultRiskLevel はリスク時間帯とみなす気圧レベルのデフォルト値です。
+	DefaultRiskLevel = models.Caution
+)
+
+// Options は気圧変化の分析条件です。ゼロ値のフィールドにはデフォルト値が使われます。
+type Options struct {
+	DayOffsets    []int                    // 分析結果に含める日付オフセット (-1: 昨日 〜 2: 明後日)。空の場合は全日
+	DropWindow    int                      // 最も急な気圧低下を探す期間 (時間)
+	DropThreshold float64                  // リスク時間帯とみなす3時間あたりの気圧低下量 (hPa、正の値)
+	RiskLevel     models.PressureLevelEnum // リスク時間帯とみなす気圧レベル (この値以上)
+}
+
+func (o Options) dropWindow() int {
+	if o.DropWindow <= 0 {
+		return DefaultDropWindow
+	}
+	return o.DropWindow
+}
+
+func (o Options) dropThreshold() float64 {
+	if o.DropThreshold <= 0 {
+		return DefaultDropThreshold
+	}
+	return o.DropThreshold
+}
+
+func (o Options) riskLevel() models.PressureLevelEnum {
+	if o.RiskLevel == "" {
+		return DefaultRiskLevel
+	}
+	return o.RiskLevel
+}
+
+// HourlyChange は1時間ごとの気圧とその変化量です。
+// 変化量は比較対象の時刻のデータが無い場合 (例: 昨日の0時の24時間変化) は nil になります。
+type HourlyChange struct {
+	At            time.Time                `json:"at"`
+	Pressure      float64                  `json:"pressure"`
+	PressureLevel models.PressureLevelEnum `json:"pressure_level"`
+	Delta         *float64                 `json:"delta"`      // 1時間前からの変化量 (hPa)
+	Change3h      *float64                 `json:"change_3h"`  // 3時間前からの変化量 (hPa/3h)
+	Change6h      *float64                 `json:"change_6h"`  // 6時間前からの変化量 (hPa/6h)
+	Change24h     *float64                 `json:"change_24h"` // 24時間前からの変化量 (hPa/24h)
+}
+
+// DropWindow は気圧が低下した期間です。
+type DropWindow struct {
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	StartPressure float64   `json:"start_pressure"`
+	EndPressure   float64   `json:"end_pressure"`
+	Change        float64   `json:"change"`        // 期間中の変化量 (hPa、負の値)
+	RatePerHour   float64   `json:"rate_per_hour"` // 1時間あたりの変化量 (hPa/h)
+}
+
+// RiskWindow は頭痛に注意が必要な連続した時間帯です。
+// 気圧レベルが Options.RiskLevel 以上か、3時間の気圧低下量が Options.DropThreshold 以上の時刻が連続する区間をまとめたものです。
+type RiskWindow struct {
+	Start     time.Time                `json:"start"`
+	End       time.Time                `json:"end"`
+	Hours     int                      `json:"hours"`
+	MaxLevel  models.PressureLevelEnum `json:"max_level"`   // 期間中の最も高い気圧レベル
+	MaxDrop3h float64                  `json:"max_drop_3h"` // 期間中の最大の3時間気圧低下量 (hPa、正の値。低下が無い場合は 0)
+	Change    float64                  `json:"change"`      // 期間の開始から終了までの気圧の変化量 (hPa)
+}
+
+// PressureAnalysis は地点の気圧予報の分析結果です。
+type PressureAnalysis struct {
+	PlaceName     string                   `json:"place_name"`
+	PlaceID       string                   `json:"place_id"`
+	DropWindow    int                      `json:"drop_window"`    // 分析に使った Options.DropWindow
+	DropThreshold float64                  `json:"drop_threshold"` // 分析に使った Options.DropThreshold
+	RiskLevel     models.PressureLevelEnum `json:"risk_level"`     // 分析に使った Options.RiskLevel
+	Hours         []HourlyChange           `json:"hours"`
+	SteepestDrop  *DropWindow              `json:"steepest_drop"` // 気圧が低下する期間が無い場合は nil
+	RiskWindows   []RiskWindow             `json:"risk_windows"`
+}
+
+// AnalyzePressure は気象状況のレスポンスから1時間ごとの気圧変化、最も急な気圧低下、リスク時間帯を計算します。
+// 変化量の計算には昨日から明後日までの全データを使うため、今日の0時の3時間変化なども求められます。
+// 時刻は WeatherStatusByTime.At (アンマーシャル時に設定) を使います。
+func AnalyzePressure(data models.GetWeatherStatusResponse, opts Options) PressureAnalysis {
+	series := pressureSeries(data)
+	byTime := make(map[time.Time]float64, len(series))
+	for _, s := range series {
+		byTime[s.At] = s.Pressure
+	}
+	changeSince := func(at time.Time, pressure float64, hours int) *float64 {
+		prev, ok := byTime[at.Add(-time.Duration(hours)*time.Hour)]
+		if !ok {
+			return nil
+		}
+		change := round(pressure - prev)
+		return &change
+	}
+
+	included := func(offset int) bool {
+		return len(opts.DayOffsets) == 0 || slices.Contains(opts.DayOffsets, offset)
+	}
+
+	result := PressureAnalysis{
+		PlaceName:     data.PlaceName,
+		PlaceID:       data.PlaceID,
+		DropWindow:    opts.dropWindow(),
+		DropThreshold: opts.dropThreshold(),
+		RiskLevel:     opts.riskLevel(),
+		Hours:         []HourlyChange{},
+		RiskWindows:   []RiskWindow{},
+	}
+	for _, s := range series {
+		if !included(s.offset) {
+			continue
+		}
+		result.Hours = append(result.Hours, HourlyChange{
+			At:            s.At,
+			Pressure:      s.Pressure,
+			PressureLevel: s.PressureLevel,
+			Delta:         changeSince(s.At, s.Pressure, 1),
+			Change3h:      changeSince(s.At, s.Pressure, 3),
+			Change6h:      changeSince(s.At, s.Pressure, 6),
+			Change24h:     changeSince(s.At, s.Pressure, 24),
+		})
+	}
+
+	result.SteepestDrop = steepestDrop(result.Hours, byTime, result.DropWindow)
+	result.RiskWindows = riskWindows(result.Hours, result.DropThreshold, result.RiskLevel)
+	return result
+}
+
+// seriesPoint は日付オフセット付きの時間別データです。
+type seriesPoint struct {
+	models.WeatherStatusByTime
+	offset int
+}
+
+// pressureSeries は昨日から明後日までの時間別データを時刻順に並べて返します。
+func pressureSeries(data models.GetWeatherStatusResponse) []seriesPoint {
+	var series []seriesPoint
+	for offset := -1; offset <= 2; offset++ {
+		dayData, _ := data.ByDayOffset(offset)
+		for _, byTime := range dayData {
+			series = append(series, seriesPoint{WeatherStatusByTime: byTime, offset: offset})
+		}
+	}
+	slices.SortStableFunc(series, func(a, b seriesPoint) int {
+		return a.At.Compare(b.At)
+	})
+	return series
+}
+
+// steepestDrop は hours の各時刻を終点とする window 時間の変化量のうち、最も大きな低下を返します。
+// 始点は hours の範囲外 (例: 今日の0時に対する昨日の21時) でも構いません。
+func steepestDrop(hours []HourlyChange, byTime map[time.Time]float64, window int) *DropWindow {
+	var steepest *DropWindow
+	for _, h := range hours {
+		start := h.At.Add(-time.Duration(window) * time.Hour)
+		startPressure, ok := byTime[start]
+		if !ok {
+			continue
+		}
+		change := round(h.Pressure - startPressure)
+		if change >= 0 || (steepest != nil && change >= steepest.Change) {
+			continue
+		}
+		steepest = &DropWindow{
+			Start:         start,
+			End:           h.At,
+			StartPressure: startPressure,
+			EndPressure:   h.Pressure,
+			Change:        change,
+			RatePerHour:   round(change / float64(window)),
+		}
+	}
+	return steepest
+}
+
+// riskWindows はリスクのある時刻が1時間おきに連続する区間を RiskWindow にまとめます。
+func riskWindows(hours []HourlyChange, threshold float64, level models.PressureLevelEnum) []RiskWindow {
+	windows := []RiskWindow{}
+	var current *RiskWindow
+	var startPressure float64
+	for _, h := range hours {
+		drop := 0.0
+		if h.Change3h != nil && *h.Change3h < 0 {
+			drop = -*h.Change3h
+		}
+		risky := LevelRank(h.PressureLevel) >= LevelRank(level) || drop >= threshold
+		if !risky {
+			current = nil
+			continue
+		}
+		if current == nil || !h.At.Equal(current.End.Add(time.Hour)) {
+			windows = append(windows, RiskWindow{Start: h.At, MaxLevel: h.PressureLevel})
+			current = &windows[len(windows)-1]
+			startPressure = h.Pressure
+		}
+		current.End = h.At
+		current.Hours++
+		current.Change = round(h.Pressure - startPressure)
+		if LevelRank(h.PressureLevel) > LevelRank(current.MaxLevel) {
+			current.MaxLevel = h.PressureLevel
+		}
+		current.MaxDrop3h = max(current.MaxDrop3h, drop)
+	}
+	return windows
+}
+
+// LevelRank は気圧レベルを比較用の数値に変換します。不明なレベルは -1 になります。
+func LevelRank(level models.PressureLevelEnum) int {
+	rank, err := strconv.Atoi(string(level))
+	if err != nil {
+		return -1
+	}
+	return rank
+}
+
+// round は浮動小数点の誤差を取り除くため、変化量を小数点以下2桁に丸めます。
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
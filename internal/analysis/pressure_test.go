@@ -0,0 +1,112 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// hourlyData は base の日付の0時から pressures の気圧を1時間ごとに持つ時間別データを作成します。
+func hourlyData(base time.Time, pressures []float64, levels map[int]models.PressureLevelEnum) []models.WeatherStatusByTime {
+	data := make([]models.WeatherStatusByTime, len(pressures))
+	for i, p := range pressures {
+		level, ok := levels[i]
+		if !ok {
+			level = models.Normal
+		}
+		data[i] = models.WeatherStatusByTime{Hour: i, Pressure: p, PressureLevel: level, At: base.Add(time.Duration(i) * time.Hour)}
+	}
+	return data
+}
+
+func TestAnalyzePressure(t *testing.T) {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	yesterday := today.AddDate(0, 0, -1)
+	data := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		PlaceID:   "113",
+		// 昨日の21時〜23時の3時間分のみ (今日の0時〜2時の3時間変化の計算に使われる)
+		Yesterday: hourlyData(yesterday, []float64{1013, 1013, 1013}, nil),
+		Today: hourlyData(today, []float64{
+			1013.0, 1012.8, 1012.6, // 0-2時: 緩やかな低下
+			1011.0, 1010.0, 1009.0, // 3-5時: 急な低下
+			1009.0, 1009.0, 1009.0, // 6-8時
+			1009.5, 1010.0, 1010.5, // 9-11時: 上昇
+		}, map[int]models.PressureLevelEnum{7: models.Alert, 8: models.SlightAlert}),
+	}
+	for i := range data.Yesterday {
+		data.Yesterday[i].At = yesterday.Add(time.Duration(21+i) * time.Hour)
+	}
+
+	res := AnalyzePressure(data, Options{DayOffsets: []int{0}})
+	assert.Equal(t, "渋谷区", res.PlaceName)
+	assert.Equal(t, DefaultDropWindow, res.DropWindow)
+	assert.Equal(t, DefaultRiskLevel, res.RiskLevel)
+	require.Len(t, res.Hours, 12, "昨日のデータは分析結果に含まれないはずです")
+
+	first := res.Hours[0]
+	require.NotNil(t, first.Delta)
+	assert.Equal(t, 0.0, *first.Delta, "0時の1時間変化は前日の23時と比較するはずです")
+	require.NotNil(t, res.Hours[2].Change3h)
+	assert.Equal(t, -0.4, *res.Hours[2].Change3h)
+	assert.Nil(t, first.Change6h, "比較対象のデータが無い場合は nil のはずです")
+	assert.Nil(t, first.Change24h)
+
+	require.NotNil(t, res.SteepestDrop)
+	assert.Equal(t, today.Add(2*time.Hour), res.SteepestDrop.Start)
+	assert.Equal(t, today.Add(5*time.Hour), res.SteepestDrop.End)
+	assert.Equal(t, -3.6, res.SteepestDrop.Change)
+	assert.Equal(t, -1.2, res.SteepestDrop.RatePerHour)
+
+	// 3-7時は3時間で1hPa以上の低下 (7時は気圧レベルも警戒) のため1つのリスク時間帯になり、
+	// 8時は気圧レベルがやや注意 (デフォルトの注意未満) のため含まれない
+	require.Len(t, res.RiskWindows, 1)
+	window := res.RiskWindows[0]
+	assert.Equal(t, today.Add(3*time.Hour), window.Start)
+	assert.Equal(t, today.Add(7*time.Hour), window.End)
+	assert.Equal(t, 5, window.Hours)
+	assert.Equal(t, models.Alert, window.MaxLevel)
+	assert.Equal(t, 3.6, window.MaxDrop3h)
+	assert.Equal(t, -2.0, window.Change)
+}
+
+func TestAnalyzePressureOptions(t *testing.T) {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{
+		Today: hourlyData(today, []float64{1010, 1009.5, 1009, 1009, 1009, 1008.6}, map[int]models.PressureLevelEnum{1: models.SlightAlert}),
+	}
+
+	res := AnalyzePressure(data, Options{DropWindow: 1, DropThreshold: 0.4, RiskLevel: models.SlightAlert})
+	require.NotNil(t, res.SteepestDrop)
+	assert.Equal(t, -0.5, res.SteepestDrop.Change, "1時間の期間で最も大きな低下を探すはずです")
+	assert.Equal(t, today.Add(1*time.Hour), res.SteepestDrop.End, "同じ低下量の場合は最初の期間を返すはずです")
+
+	// 1時は気圧レベル、3-5時は3時間で0.4hPa以上の低下 (1時は3時間前のデータが無い)
+	require.Len(t, res.RiskWindows, 2)
+	assert.Equal(t, 1, res.RiskWindows[0].Hours)
+	assert.Equal(t, today.Add(3*time.Hour), res.RiskWindows[1].Start)
+	assert.Equal(t, 3, res.RiskWindows[1].Hours)
+}
+
+func TestAnalyzePressureNoDrop(t *testing.T) {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{
+		Today: hourlyData(today, []float64{1008, 1009, 1010, 1011, 1012}, nil),
+	}
+
+	res := AnalyzePressure(data, Options{})
+	assert.Len(t, res.Hours, 5)
+	assert.Nil(t, res.SteepestDrop, "気圧が低下しない場合は nil のはずです")
+	assert.Empty(t, res.RiskWindows)
+	assert.NotNil(t, res.RiskWindows, "JSON で null にならないよう空のスライスのはずです")
+}
+
+func TestLevelRank(t *testing.T) {
+	assert.Less(t, LevelRank(models.Normal), LevelRank(models.SlightAlert))
+	assert.Less(t, LevelRank(models.Alert), LevelRank(models.SevereAlert))
+	assert.Equal(t, -1, LevelRank("unknown"))
+}
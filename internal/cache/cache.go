@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// DefaultTTLs はエンドポイントごとのデフォルトの有効期限です。
+// 地点検索の結果はほとんど変わらないため長く、痛み予報は頻繁に更新されるため短く設定しています。
+var DefaultTTLs = map[string]time.Duration{
+	api.EndpointWeatherPoint:  7 * 24 * time.Hour,
+	api.EndpointPainStatus:    10 * time.Minute,
+	api.EndpointWeatherStatus: 30 * time.Minute,
+	api.EndpointOtenkiASP:     3 * time.Hour,
+}
+
+// DefaultDir はキャッシュの保存先ディレクトリ (XDG_CACHE_HOME/zutool など) を返します。
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", i18n.Errorf("キャッシュディレクトリを特定できませんでした: %w", err)
+	}
+	return filepath.Join(dir, "zutool"), nil
+}
+
+// entry はキャッシュファイルに保存される内容です。
+type entry struct {
+	Endpoint string    `json:"endpoint"`
+	Key      string    `json:"key"`
+	StoredAt time.Time `json:"stored_at"`
+	Body     string    `json:"body"`
+}
+
+// Store はレスポンスボディをディレクトリ配下のファイルとして保存する api.Cache の実装です。
+// ファイルは <Dir>/<endpoint>/<キーのハッシュ>.json に保存されます。
+type Store struct {
+	Dir     string                   // 保存先ディレクトリ
+	TTL     map[string]time.Duration // エンドポイントごとの有効期限 (未指定のエンドポイントはキャッシュしない)
+	Refresh bool                     // true の場合、Get は常にミスし、取得結果で既存のキャッシュを上書きする
+
+	now func() time.Time // テスト用に差し替え可能な現在時刻
+}
+
+// New は dir に保存する Store を DefaultTTLs で作成します。
+func New(dir string) *Store {
+	ttl := make(map[string]time.Duration, len(DefaultTTLs))
+	for endpoint, d := range DefaultTTLs {
+		ttl[endpoint] = d
+	}
+	return &Store{Dir: dir, TTL: ttl, now: time.Now}
+}
+
+// Get は有効期限内のキャッシュがあればそのボディを返します。
+func (s *Store) Get(endpoint, key string) ([]byte, bool) {
+	if s.Refresh {
+		return nil, false
+	}
+	ttl := s.TTL[endpoint]
+	if ttl <= 0 {
+		return nil, false
+	}
+
+	e, err := readEntry(s.path(endpoint, key))
+	if err != nil || e.Key != key {
+		return nil, false
+	}
+	if s.currentTime().Sub(e.StoredAt) > ttl {
+		return nil, false
+	}
+	return []byte(e.Body), true
+}
+
+// Set はボディをキャッシュファイルに書き込みます。TTL が設定されていないエンドポイントは保存しません。
+func (s *Store) Set(endpoint, key string, body []byte) error {
+	if s.TTL[endpoint] <= 0 {
+		return nil
+	}
+
+	path := s.path(endpoint, key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return i18n.Errorf("キャッシュディレクトリの作成に失敗しました: %w", err)
+	}
+	data, err := json.Marshal(entry{
+		Endpoint: endpoint,
+		Key:      key,
+		StoredAt: s.currentTime(),
+		Body:     string(body),
+	})
+	if err != nil {
+		return i18n.Errorf("キャッシュのマーシャリングに失敗しました: %w", err)
+	}
+
+	// 書き込み途中のファイルを読まないよう、一時ファイルに書いてからリネームする
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return i18n.Errorf("キャッシュファイルの作成に失敗しました: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return i18n.Errorf("キャッシュファイルの書き込みに失敗しました: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return i18n.Errorf("キャッシュファイルの書き込みに失敗しました: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return i18n.Errorf("キャッシュファイルの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// Clear は全てのキャッシュファイルを削除し、削除した件数を返します。
+func (s *Store) Clear() (int, error) {
+	removed := 0
+	err := s.walk(func(path string, _ fs.FileInfo) error {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		return removed, i18n.Errorf("キャッシュの削除に失敗しました: %w", err)
+	}
+	return removed, nil
+}
+
+// EndpointStats はエンドポイントごとのキャッシュの統計情報です。
+type EndpointStats struct {
+	Endpoint string
+	TTL      time.Duration
+	Entries  int   // 保存されている件数
+	Expired  int   // そのうち有効期限切れの件数
+	Bytes    int64 // ファイルサイズの合計
+}
+
+// Stats はキャッシュ全体の統計情報です。
+type Stats struct {
+	Dir       string
+	Endpoints []EndpointStats // エンドポイント名の昇順
+}
+
+// Stats はキャッシュディレクトリを走査して統計情報を集計します。
+func (s *Store) Stats() (Stats, error) {
+	byEndpoint := make(map[string]*EndpointStats)
+	for endpoint, ttl := range s.TTL {
+		byEndpoint[endpoint] = &EndpointStats{Endpoint: endpoint, TTL: ttl}
+	}
+
+	now := s.currentTime()
+	err := s.walk(func(path string, info fs.FileInfo) error {
+		endpoint := filepath.Base(filepath.Dir(path))
+		st, ok := byEndpoint[endpoint]
+		if !ok {
+			st = &EndpointStats{Endpoint: endpoint, TTL: s.TTL[endpoint]}
+			byEndpoint[endpoint] = st
+		}
+		st.Entries++
+		st.Bytes += info.Size()
+		if e, err := readEntry(path); err != nil || now.Sub(e.StoredAt) > st.TTL {
+			st.Expired++
+		}
+		return nil
+	})
+	if err != nil {
+		return Stats{}, i18n.Errorf("キャッシュの集計に失敗しました: %w", err)
+	}
+
+	stats := Stats{Dir: s.Dir}
+	for _, st := range byEndpoint {
+		stats.Endpoints = append(stats.Endpoints, *st)
+	}
+	sort.Slice(stats.Endpoints, func(i, j int) bool {
+		return stats.Endpoints[i].Endpoint < stats.Endpoints[j].Endpoint
+	})
+	return stats, nil
+}
+
+// path は endpoint と key に対応するキャッシュファイルのパスを返します。
+// キーには日本語やスラッシュが含まれ得るため、ファイル名にはハッシュを使用します。
+func (s *Store) path(endpoint, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, endpoint, hex.EncodeToString(sum[:16])+".json")
+}
+
+// walk はキャッシュディレクトリ配下の全てのキャッシュファイルに対して fn を呼び出します。
+// ディレクトリが存在しない場合は何もしません。
+func (s *Store) walk(fn func(path string, info fs.FileInfo) error) error {
+	err := filepath.WalkDir(s.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(path, info)
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *Store) currentTime() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// readEntry はキャッシュファイルを読み込みます。
+func readEntry(path string) (entry, error) {
+	var e entry
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return e, err
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return e, err
+	}
+	return e, nil
+}
+
+// コンパイル時チェック: Store が api.Cache インターフェースを実装していることを保証します。
+var _ api.Cache = (*Store)(nil)
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/api"
+)
+
+// newTestStore は現在時刻を差し替え可能な Store を一時ディレクトリに作成します。
+func newTestStore(t *testing.T, now *time.Time) *Store {
+	store := New(t.TempDir())
+	store.now = func() time.Time { return *now }
+	return store
+}
+
+func TestStoreSetGet(t *testing.T) {
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	store := newTestStore(t, &now)
+
+	_, ok := store.Get(api.EndpointWeatherPoint, "渋谷")
+	assert.False(t, ok, "保存前はキャッシュミスになるはずです")
+
+	require.NoError(t, store.Set(api.EndpointWeatherPoint, "渋谷", []byte(`{"result":"[]"}`)))
+	body, ok := store.Get(api.EndpointWeatherPoint, "渋谷")
+	assert.True(t, ok)
+	assert.Equal(t, `{"result":"[]"}`, string(body))
+
+	_, ok = store.Get(api.EndpointWeatherPoint, "神戸")
+	assert.False(t, ok, "別のキーはキャッシュミスになるはずです")
+	_, ok = store.Get(api.EndpointWeatherStatus, "渋谷")
+	assert.False(t, ok, "別のエンドポイントはキャッシュミスになるはずです")
+}
+
+func TestStoreTTL(t *testing.T) {
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	store := newTestStore(t, &now)
+
+	require.NoError(t, store.Set(api.EndpointPainStatus, "13", []byte(`{}`)))
+
+	now = now.Add(DefaultTTLs[api.EndpointPainStatus] - time.Second)
+	_, ok := store.Get(api.EndpointPainStatus, "13")
+	assert.True(t, ok, "有効期限内はキャッシュヒットするはずです")
+
+	now = now.Add(2 * time.Second)
+	_, ok = store.Get(api.EndpointPainStatus, "13")
+	assert.False(t, ok, "有効期限切れはキャッシュミスになるはずです")
+}
+
+func TestStoreRefresh(t *testing.T) {
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	store := newTestStore(t, &now)
+	require.NoError(t, store.Set(api.EndpointWeatherStatus, "13113", []byte(`old`)))
+
+	store.Refresh = true
+	_, ok := store.Get(api.EndpointWeatherStatus, "13113")
+	assert.False(t, ok, "Refresh 時は常にキャッシュミスになるはずです")
+	require.NoError(t, store.Set(api.EndpointWeatherStatus, "13113", []byte(`new`)))
+
+	store.Refresh = false
+	body, ok := store.Get(api.EndpointWeatherStatus, "13113")
+	assert.True(t, ok)
+	assert.Equal(t, "new", string(body))
+}
+
+func TestStoreStatsAndClear(t *testing.T) {
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	store := newTestStore(t, &now)
+
+	stats, err := store.Stats()
+	require.NoError(t, err, "ディレクトリが存在しなくても集計できるはずです")
+	for _, st := range stats.Endpoints {
+		assert.Zero(t, st.Entries)
+	}
+
+	require.NoError(t, store.Set(api.EndpointPainStatus, "13", []byte(`{}`)))
+	require.NoError(t, store.Set(api.EndpointWeatherPoint, "渋谷", []byte(`{}`)))
+	require.NoError(t, store.Set(api.EndpointWeatherPoint, "神戸", []byte(`{}`)))
+	now = now.Add(time.Hour) // 痛み予報のみ期限切れになる
+
+	stats, err = store.Stats()
+	require.NoError(t, err)
+	got := make(map[string]EndpointStats)
+	for _, st := range stats.Endpoints {
+		got[st.Endpoint] = st
+	}
+	assert.Equal(t, 1, got[api.EndpointPainStatus].Entries)
+	assert.Equal(t, 1, got[api.EndpointPainStatus].Expired)
+	assert.Equal(t, 2, got[api.EndpointWeatherPoint].Entries)
+	assert.Equal(t, 0, got[api.EndpointWeatherPoint].Expired)
+	assert.Positive(t, got[api.EndpointWeatherPoint].Bytes)
+
+	removed, err := store.Clear()
+	require.NoError(t, err)
+	assert.Equal(t, 3, removed)
+	_, ok := store.Get(api.EndpointWeatherPoint, "渋谷")
+	assert.False(t, ok)
+}
@@ -0,0 +1,274 @@
+// Package chart は時系列データを端末に表示する折れ線グラフを描画します。
+// 点字 (Braille) 文字を使った高解像度の描画と、端末以外への出力向けの ASCII 文字による描画に対応します。
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Series はグラフに描画する1つの系列です。
+type Series struct {
+	Name   string
+	Values []float64 // 各点の値。欠損値は NaN
+	Color  string    // ANSI の色 (SGR パラメータ、例: "36")。空の場合は色を付けない
+	Colors []string  // 点ごとの色。nil でない場合は Color より優先され、点 i から点 i+1 への線は点 i の色で描画される
+	Format string    // 軸の目盛りの書式 (例: "%.1f")。空の場合は "%.1f"
+}
+
+// Options はグラフの描画オプションです。
+type Options struct {
+	Width   int      // 描画領域の幅 (文字数、軸の目盛りを除く)
+	Height  int      // 描画領域の高さ (行数)
+	Braille bool     // true の場合は点字文字で、false の場合は ASCII 文字で描画する
+	Color   bool     // true の場合は ANSI エスケープシーケンスで色を付ける
+	XLabels []string // x 軸の目盛り (各点に対応し、空文字列の点には目盛りを付けない)
+}
+
+// asciiMarkers は ASCII で描画する場合の系列ごとの文字です。
+var asciiMarkers = []rune{'*', '+', 'o', 'x'}
+
+// cell は描画領域の1文字分の状態です。
+type cell struct {
+	bits   uint8 // 点字のドット
+	marker rune  // ASCII の文字
+	series int   // 描画した系列 (複数の系列が重なった場合は先の系列を優先する)
+	color  string
+}
+
+// canvas は描画領域です。ASCII の場合は1文字が1ピクセル、点字の場合は1文字が横2×縦4ピクセルになります。
+type canvas struct {
+	cells          [][]cell
+	scaleX, scaleY int
+	braille        bool
+}
+
+func newCanvas(width, height int, braille bool) *canvas {
+	c := &canvas{cells: make([][]cell, height), scaleX: 1, scaleY: 1, braille: braille}
+	if braille {
+		c.scaleX, c.scaleY = 2, 4
+	}
+	for i := range c.cells {
+		c.cells[i] = make([]cell, width)
+		for j := range c.cells[i] {
+			c.cells[i][j].series = -1
+		}
+	}
+	return c
+}
+
+// brailleBits は点字のセル内の位置 (x: 0-1, y: 0-3) に対応するドットのビットです。
+var brailleBits = [4][2]uint8{{0x01, 0x08}, {0x02, 0x10}, {0x04, 0x20}, {0x40, 0x80}}
+
+// set はピクセル (px, py) に系列 series の点を描画します。
+func (c *canvas) set(px, py, series int, color string) {
+	cy, cx := py/c.scaleY, px/c.scaleX
+	if cy < 0 || cy >= len(c.cells) || cx < 0 || cx >= len(c.cells[cy]) {
+		return
+	}
+	cl := &c.cells[cy][cx]
+	if c.braille {
+		cl.bits |= brailleBits[py%4][px%2]
+	} else if cl.series < 0 || series < cl.series {
+		cl.marker = asciiMarkers[series%len(asciiMarkers)]
+	}
+	if cl.series < 0 || series < cl.series {
+		cl.series = series
+		cl.color = color
+	}
+}
+
+// line はピクセル (x0, y0) から (x1, y1) までの線を描画します (ブレゼンハムのアルゴリズム)。
+func (c *canvas) line(x0, y0, x1, y1, series int, color string) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := sign(x1-x0), sign(y1-y0)
+	err := dx + dy
+	for {
+		c.set(x0, y0, series, color)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// valueRange は系列の欠損値を除いた最小値と最大値を返します。値が1種類しかない場合は上下に幅を持たせます。
+func valueRange(values []float64) (float64, float64, bool) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	if math.IsInf(lo, 1) {
+		return 0, 0, false
+	}
+	if hi-lo < 1e-9 {
+		lo, hi = lo-0.5, hi+0.5
+	}
+	return lo, hi, true
+}
+
+// Render は series を重ねた折れ線グラフを描画し、行ごとの文字列を返します。
+// 最初の系列の目盛りは左側に、2番目の系列の目盛りは右側に表示され、各系列はそれぞれの値の範囲に合わせて拡大されます。
+// 3番目以降の系列は目盛りを表示しません。
+func Render(opts Options, series ...Series) []string {
+	if opts.Width < 1 || opts.Height < 2 || len(series) == 0 {
+		return nil
+	}
+	c := newCanvas(opts.Width, opts.Height, opts.Braille)
+	pw, ph := opts.Width*c.scaleX, opts.Height*c.scaleY
+
+	type scale struct {
+		lo, hi float64
+		ok     bool // 欠損値以外の値があるか
+	}
+	scales := make([]scale, len(series))
+	points := 0
+	for i, s := range series {
+		points = max(points, len(s.Values))
+		lo, hi, ok := valueRange(s.Values)
+		scales[i] = scale{lo, hi, ok}
+	}
+	xOf := func(i int) int {
+		if points <= 1 {
+			return 0
+		}
+		return int(math.Round(float64(i) * float64(pw-1) / float64(points-1)))
+	}
+
+	for si, s := range series {
+		sc := scales[si]
+		yOf := func(v float64) int {
+			return int(math.Round((sc.hi - v) / (sc.hi - sc.lo) * float64(ph-1)))
+		}
+		colorAt := func(i int) string {
+			if !opts.Color {
+				return ""
+			}
+			if s.Colors != nil && i < len(s.Colors) {
+				return s.Colors[i]
+			}
+			return s.Color
+		}
+		for i, v := range s.Values {
+			if math.IsNaN(v) {
+				continue
+			}
+			if i+1 < len(s.Values) && !math.IsNaN(s.Values[i+1]) {
+				c.line(xOf(i), yOf(v), xOf(i+1), yOf(s.Values[i+1]), si, colorAt(i))
+			} else {
+				c.set(xOf(i), yOf(v), si, colorAt(i))
+			}
+		}
+	}
+
+	// 目盛りは上端・中央・下端の行に、その行の中央のピクセルの値を表示する
+	axisLabel := func(si, row int) string {
+		if si >= len(series) || !scales[si].ok {
+			return ""
+		}
+		if row != 0 && row != opts.Height/2 && row != opts.Height-1 {
+			return ""
+		}
+		sc := scales[si]
+		py := float64(row*c.scaleY) + float64(c.scaleY-1)/2
+		format := series[si].Format
+		if format == "" {
+			format = "%.1f"
+		}
+		return fmt.Sprintf(format, sc.hi-py/float64(ph-1)*(sc.hi-sc.lo))
+	}
+	labelWidth := 0
+	for row := 0; row < opts.Height; row++ {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(axisLabel(0, row)))
+	}
+
+	vertical, tick, corner, horizontal := "│", "┤", "└", "─"
+	if !opts.Braille {
+		vertical, tick, corner, horizontal = "|", "+", "+", "-"
+	}
+
+	lines := make([]string, 0, opts.Height+2)
+	for row, cells := range c.cells {
+		var b strings.Builder
+		left := axisLabel(0, row)
+		b.WriteString(strings.Repeat(" ", labelWidth-utf8.RuneCountInString(left)) + left)
+		if left != "" {
+			b.WriteString(tick)
+		} else {
+			b.WriteString(vertical)
+		}
+		for _, cl := range cells {
+			ch := ' '
+			switch {
+			case opts.Braille && cl.bits != 0:
+				ch = rune(0x2800 + int(cl.bits))
+			case !opts.Braille && cl.marker != 0:
+				ch = cl.marker
+			}
+			if cl.color != "" && ch != ' ' {
+				fmt.Fprintf(&b, "\x1b[%sm%c\x1b[0m", cl.color, ch)
+			} else {
+				b.WriteRune(ch)
+			}
+		}
+		if right := axisLabel(1, row); right != "" {
+			b.WriteString(" " + right)
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	lines = append(lines, strings.Repeat(" ", labelWidth)+corner+strings.Repeat(horizontal, opts.Width))
+	if xLabels := renderXLabels(opts.XLabels, opts.Width, func(i int) int { return xOf(i) / c.scaleX }); xLabels != "" {
+		lines = append(lines, strings.Repeat(" ", labelWidth+1)+xLabels)
+	}
+	return lines
+}
+
+// renderXLabels は x 軸の目盛りを、各点の位置 (column) から始まるように並べます。前の目盛りと重なる目盛りは省略します。
+func renderXLabels(labels []string, width int, column func(int) int) string {
+	line := []rune(strings.Repeat(" ", width))
+	next := 0
+	for i, label := range labels {
+		if label == "" {
+			continue
+		}
+		col := column(i)
+		runes := []rune(label)
+		if col < next || col+len(runes) > width {
+			continue
+		}
+		copy(line[col:], runes)
+		next = col + len(runes) + 1
+	}
+	return strings.TrimRight(string(line), " ")
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
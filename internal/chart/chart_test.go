@@ -0,0 +1,60 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderASCII(t *testing.T) {
+	opts := Options{Width: 8, Height: 4, XLabels: []string{"00", "", "", "", "", "06"}}
+	pressure := Series{Values: []float64{1000, 1001, 1002, math.NaN(), 1001, 1000}}
+	temp := Series{Values: []float64{10, 10, 12, 12, 14, 14}}
+
+	expected := []string{
+		"1002.0+   *  ++ 14.0",
+		"      |  *  +",
+		"1000.7+ *+++ *  11.3",
+		"1000.0+*+     * 10.0",
+		"      +--------",
+		"       00",
+	}
+	assert.Equal(t, expected, Render(opts, pressure, temp), "欠損値 (NaN) の前後は線でつながず、系列ごとに左右の目盛りが表示されるはずです")
+}
+
+func TestRenderBraille(t *testing.T) {
+	opts := Options{Width: 2, Height: 2, Braille: true, Color: true}
+	series := Series{Values: []float64{1, 2}, Colors: []string{"31", "33"}}
+
+	expected := []string{
+		"1.8┤ \x1b[31m⡜\x1b[0m",
+		"1.2┤\x1b[31m⡜\x1b[0m",
+		"   └──",
+	}
+	assert.Equal(t, expected, Render(opts, series), "点字文字で描画され、線は始点の色で表示されるはずです")
+}
+
+func TestRenderNoColor(t *testing.T) {
+	opts := Options{Width: 4, Height: 2, Braille: true}
+	lines := Render(opts, Series{Values: []float64{1, 2, 3}, Color: "36"})
+	for _, line := range lines {
+		assert.NotContains(t, line, "\x1b[", "Color が false の場合は色を付けないはずです")
+	}
+}
+
+func TestRenderEmpty(t *testing.T) {
+	assert.Nil(t, Render(Options{Width: 10, Height: 5}), "系列が無い場合は何も描画しないはずです")
+	assert.Nil(t, Render(Options{Width: 0, Height: 5}, Series{Values: []float64{1}}), "幅が0の場合は何も描画しないはずです")
+
+	lines := Render(Options{Width: 4, Height: 2}, Series{Values: []float64{math.NaN(), math.NaN()}})
+	assert.Equal(t, []string{"|", "|", "+----"}, lines, "値が全て欠損している場合は軸だけが描画されるはずです")
+}
+
+func TestRenderXLabels(t *testing.T) {
+	labels := renderXLabels([]string{"05/20", "06:00", "12:00"}, 12, func(i int) int { return i * 4 })
+	assert.Equal(t, "05/20", labels, "前の目盛りと重なる目盛りや、幅からはみ出す目盛りは省略されるはずです")
+
+	labels = renderXLabels([]string{"AA", "", "BB"}, 10, func(i int) int { return i * 3 })
+	assert.Equal(t, "AA    BB", labels)
+}
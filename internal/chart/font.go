@@ -0,0 +1,40 @@
+package chart
+
+import "unicode/utf8"
+
+// このファイルは PNG の目盛りを描くための小さなビットマップフォントを定義します。
+// 目盛りに使う数字と記号 (. : / -) だけを、3×5 ドットを2倍に拡大して描画します。
+
+const (
+	glyphCols    = 3
+	glyphScale   = 2
+	glyphHeight  = 5 * glyphScale
+	glyphAdvance = (glyphCols + 1) * glyphScale // 文字の間隔を含めた1文字の幅
+)
+
+// glyphs は文字ごとの各行のドットです (上位ビットが左)。
+var glyphs = map[rune][5]uint8{
+	'0': {0b111, 0b101, 0b101, 0b101, 0b111},
+	'1': {0b010, 0b110, 0b010, 0b010, 0b111},
+	'2': {0b111, 0b001, 0b111, 0b100, 0b111},
+	'3': {0b111, 0b001, 0b111, 0b001, 0b111},
+	'4': {0b101, 0b101, 0b111, 0b001, 0b001},
+	'5': {0b111, 0b100, 0b111, 0b001, 0b111},
+	'6': {0b111, 0b100, 0b111, 0b101, 0b111},
+	'7': {0b111, 0b001, 0b010, 0b010, 0b010},
+	'8': {0b111, 0b101, 0b111, 0b101, 0b111},
+	'9': {0b111, 0b101, 0b111, 0b001, 0b111},
+	'.': {0b000, 0b000, 0b000, 0b000, 0b010},
+	':': {0b000, 0b010, 0b000, 0b010, 0b000},
+	'/': {0b001, 0b001, 0b010, 0b100, 0b100},
+	'-': {0b000, 0b000, 0b111, 0b000, 0b000},
+}
+
+// textWidth は文字列を描画したときの幅 (ピクセル) を返します。
+func textWidth(s string) float64 {
+	n := utf8.RuneCountInString(s)
+	if n == 0 {
+		return 0
+	}
+	return float64(n*glyphAdvance - glyphScale)
+}
@@ -0,0 +1,69 @@
+package chart
+
+import "image/color"
+
+// このファイルは天気のアイコンを円と線の組み合わせで定義します。SVG と PNG で同じ形を描くため、
+// フォントや絵文字には頼らずに図形として描画します。
+
+// shapeKind は図形の種類です。
+type shapeKind int
+
+const (
+	shapeCircle shapeKind = iota
+	shapeLine
+)
+
+// shape はアイコンを構成する図形です。円の場合は (x1, y1) が中心、r が半径で fill で塗りつぶします。
+// 線の場合は (x1, y1) から (x2, y2) まで stroke の色で描きます。
+type shape struct {
+	kind           shapeKind
+	x1, y1, x2, y2 float64
+	r              float64
+	fill, stroke   color.RGBA
+}
+
+var (
+	sunColor   = color.RGBA{0xf5, 0xb4, 0x00, 0xff}
+	cloudColor = color.RGBA{0x9a, 0xa5, 0xb1, 0xff}
+	rainColor  = color.RGBA{0x2f, 0x7d, 0xd1, 0xff}
+	snowColor  = color.RGBA{0x5a, 0xa0, 0xd8, 0xff}
+)
+
+// iconShapes は中心が (x, y) の天気のアイコンの図形を返します。
+func iconShapes(kind weatherIcon, x, y float64) []shape {
+	r := float64(iconRadius)
+	circle := func(cx, cy, cr float64, fill color.RGBA) shape {
+		return shape{kind: shapeCircle, x1: cx, y1: cy, r: cr, fill: fill}
+	}
+	line := func(x1, y1, x2, y2 float64, stroke color.RGBA) shape {
+		return shape{kind: shapeLine, x1: x1, y1: y1, x2: x2, y2: y2, stroke: stroke}
+	}
+	// cloud は3つの円を重ねた雲です。
+	cloud := func(cy float64) []shape {
+		return []shape{
+			circle(x-r*0.45, cy+r*0.15, r*0.5, cloudColor),
+			circle(x+r*0.1, cy-r*0.15, r*0.6, cloudColor),
+			circle(x+r*0.5, cy+r*0.2, r*0.45, cloudColor),
+		}
+	}
+
+	switch kind {
+	case iconSunny:
+		return []shape{circle(x, y, r*0.75, sunColor)}
+	case iconCloudy:
+		return cloud(y)
+	case iconRain:
+		return append(cloud(y-r*0.3),
+			line(x-r*0.4, y+r*0.45, x-r*0.6, y+r, rainColor),
+			line(x+r*0.3, y+r*0.45, x+r*0.1, y+r, rainColor),
+		)
+	case iconSnow:
+		return []shape{
+			line(x-r*0.8, y, x+r*0.8, y, snowColor),
+			line(x-r*0.4, y-r*0.7, x+r*0.4, y+r*0.7, snowColor),
+			line(x-r*0.4, y+r*0.7, x+r*0.4, y-r*0.7, snowColor),
+		}
+	default:
+		return nil
+	}
+}
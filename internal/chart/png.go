@@ -0,0 +1,145 @@
+package chart
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// WritePNG は画像を PNG 形式で w に出力します。
+// 標準の image パッケージだけで描画するため、目盛りの数字は組み込みのビットマップフォント (font.go) で描き、
+// 日本語のタイトル・凡例・「現在」の文字は表示しません。
+func (c *PressureChart) WritePNG(w io.Writer) error {
+	l := c.layout()
+	img := image.NewRGBA(image.Rect(0, 0, l.width, l.height))
+	r := raster{img}
+	r.fillRect(0, 0, float64(l.width), float64(l.height), backgroundColor, 1)
+
+	for _, band := range l.bands {
+		r.fillRect(band.x0, l.top, band.x1, l.base, band.color, bandOpacity)
+	}
+	for _, v := range l.yTicks {
+		y := l.yOf(v)
+		r.line(l.left, y, l.right, y, 1, gridColor)
+		label := formatPressure(v)
+		r.text(l.left-6-textWidth(label), y-glyphHeight/2, label, textColor)
+	}
+	for _, tick := range l.xTicks {
+		r.line(tick.x, l.base, tick.x, l.base+4, 1, axisColor)
+		r.text(tick.x-textWidth(tick.label)/2, l.base+8, tick.label, textColor)
+	}
+	r.line(l.left, l.top, l.left, l.base, 1, axisColor)
+	r.line(l.left, l.base, l.right, l.base, 1, axisColor)
+
+	for _, line := range l.lines {
+		for i := 1; i < len(line); i++ {
+			r.line(line[i-1].x, line[i-1].y, line[i].x, line[i].y, 2, lineColor)
+		}
+		if len(line) == 1 {
+			r.disc(line[0].x, line[0].y, 1.5, lineColor)
+		}
+	}
+
+	for _, icon := range l.icons {
+		for _, s := range iconShapes(icon.kind, icon.x, icon.y) {
+			switch s.kind {
+			case shapeCircle:
+				r.disc(s.x1, s.y1, s.r, s.fill)
+			case shapeLine:
+				r.line(s.x1, s.y1, s.x2, s.y2, 1.5, s.stroke)
+			}
+		}
+	}
+
+	if l.showNow {
+		// 4ピクセル描いて3ピクセル空ける破線
+		for y := l.top; y < l.base; y += 7 {
+			r.line(l.nowX, y, l.nowX, math.Min(y+4, l.base), 1.5, nowColor)
+		}
+	}
+
+	if err := png.Encode(w, img); err != nil {
+		return i18n.Errorf("PNG の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// raster は image.RGBA に図形を描画するヘルパーです。
+type raster struct {
+	img *image.RGBA
+}
+
+// blend は (x, y) のピクセルに色 c を不透明度 alpha で重ねます。
+func (r raster) blend(x, y int, c color.RGBA, alpha float64) {
+	if !(image.Point{x, y}.In(r.img.Rect)) || alpha <= 0 {
+		return
+	}
+	alpha = math.Min(alpha, 1)
+	bg := r.img.RGBAAt(x, y)
+	mix := func(fg, bg uint8) uint8 {
+		return uint8(math.Round(float64(fg)*alpha + float64(bg)*(1-alpha)))
+	}
+	r.img.SetRGBA(x, y, color.RGBA{mix(c.R, bg.R), mix(c.G, bg.G), mix(c.B, bg.B), 0xff})
+}
+
+// fillRect は (x0, y0) から (x1, y1) までの長方形を塗りつぶします。
+func (r raster) fillRect(x0, y0, x1, y1 float64, c color.RGBA, alpha float64) {
+	for y := int(math.Round(y0)); y < int(math.Round(y1)); y++ {
+		for x := int(math.Round(x0)); x < int(math.Round(x1)); x++ {
+			r.blend(x, y, c, alpha)
+		}
+	}
+}
+
+// disc は中心 (cx, cy)、半径 radius の円を塗りつぶします。縁は中心からの距離に応じて半透明にして滑らかにします。
+func (r raster) disc(cx, cy, radius float64, c color.RGBA) {
+	for y := int(math.Floor(cy - radius - 1)); y <= int(math.Ceil(cy+radius+1)); y++ {
+		for x := int(math.Floor(cx - radius - 1)); x <= int(math.Ceil(cx+radius+1)); x++ {
+			d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			r.blend(x, y, c, radius+0.5-d)
+		}
+	}
+}
+
+// line は (x0, y0) から (x1, y1) まで太さ width の線を描きます。
+// 各ピクセルの中心から線分までの距離で不透明度を決めるため、斜めの線も滑らかになります。
+func (r raster) line(x0, y0, x1, y1, width float64, c color.RGBA) {
+	half := width / 2
+	dx, dy := x1-x0, y1-y0
+	length2 := dx*dx + dy*dy
+	for y := int(math.Floor(math.Min(y0, y1) - half - 1)); y <= int(math.Ceil(math.Max(y0, y1)+half+1)); y++ {
+		for x := int(math.Floor(math.Min(x0, x1) - half - 1)); x <= int(math.Ceil(math.Max(x0, x1)+half+1)); x++ {
+			px, py := float64(x)+0.5, float64(y)+0.5
+			t := 0.0
+			if length2 > 0 {
+				t = math.Max(0, math.Min(1, ((px-x0)*dx+(py-y0)*dy)/length2))
+			}
+			d := math.Hypot(px-(x0+t*dx), py-(y0+t*dy))
+			if alpha := half + 0.5 - d; alpha > 0 {
+				r.blend(x, y, c, alpha)
+			}
+		}
+	}
+}
+
+// text は左上が (x, y) の位置に文字列を描きます。フォントに無い文字は空白になります。
+func (r raster) text(x, y float64, s string, c color.RGBA) {
+	ox, oy := int(math.Round(x)), int(math.Round(y))
+	for _, ch := range s {
+		if glyph, ok := glyphs[ch]; ok {
+			for row, bits := range glyph {
+				for col := 0; col < glyphCols; col++ {
+					if bits&(1<<(glyphCols-1-col)) != 0 {
+						r.fillRect(float64(ox+col*glyphScale), float64(oy+row*glyphScale),
+							float64(ox+(col+1)*glyphScale), float64(oy+(row+1)*glyphScale), c, 1)
+					}
+				}
+			}
+		}
+		ox += glyphAdvance
+	}
+}
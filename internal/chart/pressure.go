@@ -0,0 +1,275 @@
+package chart
+
+import (
+	"image/color"
+	"io"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// このファイルは気圧予報をダッシュボードやチャットに埋め込む画像 (SVG/PNG) として出力するための PressureChart を定義します。
+// 座標の計算は pressureLayout にまとめ、svg.go と png.go はそれぞれの形式で描画するだけにしています。
+
+// ExportFormats は PressureChart を出力できる画像形式 (ファイルの拡張子) です。
+var ExportFormats = []string{"svg", "png"}
+
+// 画像の既定の大きさ (ピクセル) です。
+const (
+	DefaultChartWidth  = 960
+	DefaultChartHeight = 400
+)
+
+// PressurePoint は気圧予報の1時間分の値です。
+type PressurePoint struct {
+	At       time.Time
+	Pressure float64
+	Level    models.PressureLevelEnum
+	Weather  models.WeatherEnum
+}
+
+// PressureChart は気圧予報の画像です。気圧の推移を折れ線で描き、背景を気圧レベルごとの色の帯で塗り分け、
+// 上部に1時間ごとの天気のアイコンを、現在時刻の位置に縦線を表示します。
+type PressureChart struct {
+	Title  string
+	Points []PressurePoint // 時刻順。1時間より間隔が空いた点の間は線でつながない
+	Now    time.Time       // 現在時刻。ゼロ値または表示範囲外の場合は縦線を表示しない
+	Width  int             // 画像の幅 (0 の場合は DefaultChartWidth)
+	Height int             // 画像の高さ (0 の場合は DefaultChartHeight)
+}
+
+// NewPressureChart は dayOffsets の日の気象状況から PressureChart を作成します。
+func NewPressureChart(data models.GetWeatherStatusResponse, dayOffsets []int) (*PressureChart, error) {
+	c := &PressureChart{Title: i18n.Sprintf("%sの気圧予報", i18n.PlaceName(data.PlaceName))}
+	for _, dayOffset := range dayOffsets {
+		dayData, ok := data.ByDayOffset(dayOffset)
+		if !ok {
+			return nil, i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+		}
+		for _, byTime := range dayData {
+			c.Points = append(c.Points, PressurePoint{At: byTime.At, Pressure: byTime.Pressure, Level: byTime.PressureLevel, Weather: byTime.Weather})
+		}
+	}
+	if len(c.Points) == 0 {
+		return nil, i18n.Errorf("グラフにする気圧データがありません")
+	}
+	return c, nil
+}
+
+// Write は format (ExportFormats のいずれか) の画像を w に出力します。
+func (c *PressureChart) Write(w io.Writer, format string) error {
+	switch format {
+	case "svg":
+		return c.WriteSVG(w)
+	case "png":
+		return c.WritePNG(w)
+	default:
+		return i18n.Errorf("未対応の画像形式です: %s (%s のいずれかを指定してください)", format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// ExportFormat はファイルのパスの拡張子から画像形式を返します。
+func ExportFormat(path string) (string, error) {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	for _, f := range ExportFormats {
+		if format == f {
+			return format, nil
+		}
+	}
+	return "", i18n.Errorf("出力ファイルの拡張子から画像形式を判別できません: %s (.svg または .png を指定してください)", path)
+}
+
+// 画像で使う色です。気圧レベルの帯の色は HTML 出力の気圧レベルのセルと同じです。
+var (
+	backgroundColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	gridColor       = color.RGBA{0xdd, 0xdd, 0xdd, 0xff}
+	axisColor       = color.RGBA{0x66, 0x66, 0x66, 0xff}
+	textColor       = color.RGBA{0x22, 0x22, 0x22, 0xff}
+	lineColor       = color.RGBA{0x1f, 0x4e, 0x9c, 0xff}
+	nowColor        = color.RGBA{0xe0, 0x1e, 0x1e, 0xff}
+	levelBandColors = map[models.PressureLevelEnum]color.RGBA{
+		models.SlightAlert: {0xff, 0xf6, 0xbf, 0xff},
+		models.Caution:     {0xff, 0xd5, 0x9e, 0xff},
+		models.Alert:       {0xff, 0x9e, 0x9e, 0xff},
+		models.SevereAlert: {0xd9, 0x53, 0x4f, 0xff},
+	}
+)
+
+// bandOpacity は気圧レベルの帯の不透明度です。帯の上の折れ線や目盛りが見えるよう半透明にします。
+const bandOpacity = 0.5
+
+// 余白 (ピクセル) です。上の余白にはタイトルと天気のアイコンを、左と下の余白には目盛りを表示します。
+const (
+	marginTop    = 64
+	marginRight  = 24
+	marginBottom = 36
+	marginLeft   = 64
+	iconRadius   = 7
+)
+
+// weatherIcon は天気のアイコンの種類です。
+type weatherIcon int
+
+const (
+	iconNone weatherIcon = iota
+	iconSunny
+	iconCloudy
+	iconRain
+	iconSnow
+)
+
+// iconOf は天気コードの主な天気からアイコンの種類を返します。雷雨は雨、みぞれは雪、霧はくもりのアイコンで表します。
+func iconOf(weather models.WeatherEnum) weatherIcon {
+	info, _ := weather.Info()
+	switch info.Primary {
+	case models.ConditionSunny:
+		return iconSunny
+	case models.ConditionCloudy, models.ConditionFog:
+		return iconCloudy
+	case models.ConditionRain, models.ConditionThunderstorm:
+		return iconRain
+	case models.ConditionSnow, models.ConditionSleet:
+		return iconSnow
+	default:
+		return iconNone
+	}
+}
+
+// pressureLayout は PressureChart の各要素の座標を計算した結果です。
+type pressureLayout struct {
+	width, height          int
+	left, top, right, base float64 // 描画領域の左端・上端・右端・下端
+	start, end             time.Time
+	lo, hi                 float64 // y 軸の範囲 (hPa)
+	hourWidth              float64 // 1時間分の幅
+	yTicks                 []float64
+	xTicks                 []xTick
+	bands                  []band
+	lines                  [][]point // 線でつなぐ点の列
+	icons                  []icon
+	nowX                   float64
+	showNow                bool
+}
+
+type point struct{ x, y float64 }
+
+type xTick struct {
+	x     float64
+	label string
+}
+
+type band struct {
+	x0, x1 float64
+	color  color.RGBA
+	level  models.PressureLevelEnum
+}
+
+type icon struct {
+	x, y    float64
+	kind    weatherIcon
+	weather models.WeatherEnum
+}
+
+// layout は画像の大きさに合わせて各要素の座標を計算します。
+func (c *PressureChart) layout() pressureLayout {
+	l := pressureLayout{width: c.Width, height: c.Height}
+	if l.width <= 0 {
+		l.width = DefaultChartWidth
+	}
+	if l.height <= 0 {
+		l.height = DefaultChartHeight
+	}
+	l.left, l.top = marginLeft, marginTop
+	l.right, l.base = float64(l.width-marginRight), float64(l.height-marginBottom)
+
+	// x 軸は最初の点から最後の点の1時間後までで、各点は1時間分の幅の中央に配置する
+	l.start, l.end = c.Points[0].At, c.Points[len(c.Points)-1].At.Add(time.Hour)
+	hours := l.end.Sub(l.start).Hours()
+	l.hourWidth = (l.right - l.left) / hours
+	xOf := func(t time.Time) float64 {
+		return l.left + (t.Sub(l.start).Hours()+0.5)*l.hourWidth
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, p := range c.Points {
+		lo, hi = math.Min(lo, p.Pressure), math.Max(hi, p.Pressure)
+	}
+	step := tickStep(hi - lo)
+	l.lo, l.hi = math.Floor(lo/step)*step, math.Ceil(hi/step)*step
+	if l.hi-l.lo < step {
+		l.lo, l.hi = l.lo-step, l.hi+step
+	}
+	for v := l.lo; v <= l.hi+step/2; v += step {
+		l.yTicks = append(l.yTicks, v)
+	}
+
+	// アイコンは重ならないよう、1時間の幅が狭い場合は数時間おきに表示する
+	iconStep := max(1, int(math.Ceil(float64(2*iconRadius+2)/l.hourWidth)))
+	// 時刻の目盛りは0時に日付、6時間ごとに時刻を表示し、幅が狭い場合は0時だけにする
+	labelHours := 6
+	if l.hourWidth*6 < 44 {
+		labelHours = 24
+	}
+
+	var run []point
+	for i, p := range c.Points {
+		x := xOf(p.At)
+		if level, ok := levelBandColors[p.Level]; ok {
+			x0, x1 := x-l.hourWidth/2, x+l.hourWidth/2
+			// 同じ気圧レベルが続く場合は1つの帯にまとめる
+			if n := len(l.bands); n > 0 && l.bands[n-1].level == p.Level && math.Abs(l.bands[n-1].x1-x0) < 1e-6 {
+				l.bands[n-1].x1 = x1
+			} else {
+				l.bands = append(l.bands, band{x0: x0, x1: x1, color: level, level: p.Level})
+			}
+		}
+		if i > 0 && p.At.Sub(c.Points[i-1].At) > time.Hour {
+			l.lines = append(l.lines, run)
+			run = nil
+		}
+		run = append(run, point{x, l.yOf(p.Pressure)})
+		if p.At.Hour()%iconStep == 0 {
+			if kind := iconOf(p.Weather); kind != iconNone {
+				l.icons = append(l.icons, icon{x: x, y: l.top - iconRadius - 6, kind: kind, weather: p.Weather})
+			}
+		}
+		switch hour := p.At.Hour(); {
+		case hour == 0:
+			l.xTicks = append(l.xTicks, xTick{x, p.At.Format("01/02")})
+		case hour%labelHours == 0:
+			l.xTicks = append(l.xTicks, xTick{x, p.At.Format("15:04")})
+		}
+	}
+	l.lines = append(l.lines, run)
+
+	if !c.Now.IsZero() && !c.Now.Before(l.start) && c.Now.Before(l.end) {
+		l.showNow = true
+		l.nowX = l.left + c.Now.Sub(l.start).Hours()*l.hourWidth
+	}
+	return l
+}
+
+// yOf は気圧 pressure の y 座標を返します。
+func (l pressureLayout) yOf(pressure float64) float64 {
+	return l.base - (pressure-l.lo)/(l.hi-l.lo)*(l.base-l.top)
+}
+
+// tickStep は y 軸の目盛りが5本程度になる、1・2・5 hPa の倍数の間隔を返します。
+func tickStep(span float64) float64 {
+	for _, step := range []float64{1, 2, 5, 10, 20, 50} {
+		if span/step <= 6 {
+			return step
+		}
+	}
+	return 100
+}
+
+// formatPressure は y 軸の目盛りの気圧を整形します。
+func formatPressure(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// testPressureData は今日と明後日の6時間分のデータを持つ気象状況です。今日の3時から5時は警戒レベルです。
+func testPressureData() models.GetWeatherStatusResponse {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{PlaceName: "渋谷区"}
+	for h := 0; h < 6; h++ {
+		level := models.Normal
+		if h >= 3 {
+			level = models.Alert
+		}
+		data.Today = append(data.Today, models.WeatherStatusByTime{
+			Hour: h, At: today.Add(time.Duration(h) * time.Hour), Pressure: 1012 - float64(h), PressureLevel: level, Weather: models.Rain,
+		})
+		data.DayAfterTomorrow = append(data.DayAfterTomorrow, models.WeatherStatusByTime{
+			Hour: h, At: today.AddDate(0, 0, 2).Add(time.Duration(h) * time.Hour), Pressure: 1008, PressureLevel: models.Normal, Weather: models.Sunny,
+		})
+	}
+	return data
+}
+
+func TestNewPressureChart(t *testing.T) {
+	c, err := NewPressureChart(testPressureData(), []int{0, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "渋谷区の気圧予報", c.Title)
+	assert.Len(t, c.Points, 12)
+
+	_, err = NewPressureChart(testPressureData(), []int{1})
+	assert.EqualError(t, err, "グラフにする気圧データがありません")
+	_, err = NewPressureChart(testPressureData(), []int{3})
+	assert.EqualError(t, err, "無効な日付オフセットが提供されました: 3")
+}
+
+func TestPressureChartLayout(t *testing.T) {
+	c, err := NewPressureChart(testPressureData(), []int{0, 2})
+	require.NoError(t, err)
+	l := c.layout()
+
+	require.Len(t, l.bands, 1, "同じ気圧レベルが続く時間は1つの帯にまとめられるはずです")
+	assert.Equal(t, models.Alert, l.bands[0].level)
+	assert.InDelta(t, 3*l.hourWidth, l.bands[0].x1-l.bands[0].x0, 1e-6, "帯の幅は3時間分のはずです")
+
+	require.Len(t, l.lines, 2, "連続していない日の間は線でつながないはずです")
+	assert.Len(t, l.lines[0], 6)
+	assert.Len(t, l.lines[1], 6)
+
+	assert.Equal(t, []float64{1007, 1008, 1009, 1010, 1011, 1012}, l.yTicks)
+	assert.InDelta(t, l.top, l.lines[0][0].y, 1e-6, "最大の気圧は描画領域の上端になるはずです")
+	assert.InDelta(t, l.base, l.lines[0][5].y, 1e-6, "最小の気圧は描画領域の下端になるはずです")
+	assert.Equal(t, "05/20", l.xTicks[0].label)
+	assert.False(t, l.showNow, "現在時刻が指定されていない場合は縦線を表示しないはずです")
+}
+
+func TestPressureChartWriteSVG(t *testing.T) {
+	c, err := NewPressureChart(testPressureData(), []int{0, 2})
+	require.NoError(t, err)
+	c.Now = time.Date(2025, 5, 20, 2, 30, 0, 0, models.JST)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Write(&buf, "svg"))
+	svg := buf.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="960" height="400"`))
+	assert.Contains(t, svg, ">渋谷区の気圧予報</text>")
+	assert.Contains(t, svg, `<rect class="level-4" `, "気圧レベルの帯が描画されるはずです")
+	assert.Equal(t, 2, strings.Count(svg, `<polyline class="pressure"`), "連続した時間ごとに折れ線が描画されるはずです")
+	assert.Contains(t, svg, `<g class="weather"><title>雨</title>`, "天気のアイコンが描画されるはずです")
+	assert.Contains(t, svg, `<line class="now" `, "現在時刻の線が描画されるはずです")
+	assert.True(t, strings.HasSuffix(svg, "</svg>\n"))
+}
+
+func TestPressureChartWritePNG(t *testing.T) {
+	c, err := NewPressureChart(testPressureData(), []int{0, 2})
+	require.NoError(t, err)
+	c.Width, c.Height = 480, 200
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Write(&buf, "png"))
+	img, err := png.Decode(&buf)
+	require.NoError(t, err, "PNG として読み込めるはずです")
+	assert.Equal(t, 480, img.Bounds().Dx())
+	assert.Equal(t, 200, img.Bounds().Dy())
+
+	l := c.layout()
+	r, g, b, _ := img.At(int(l.bands[0].x0)+2, int(l.base)-2).RGBA()
+	assert.Equal(t, [3]uint32{0xff, 0xcf, 0xcf}, [3]uint32{r >> 8, g >> 8, b >> 8}, "警戒の帯は背景と半透明で重ねた色になるはずです")
+}
+
+func TestExportFormat(t *testing.T) {
+	format, err := ExportFormat("chart.svg")
+	require.NoError(t, err)
+	assert.Equal(t, "svg", format)
+	format, err = ExportFormat("/tmp/CHART.PNG")
+	require.NoError(t, err)
+	assert.Equal(t, "png", format)
+	_, err = ExportFormat("chart.gif")
+	assert.Error(t, err)
+
+	c := &PressureChart{Points: []PressurePoint{{Pressure: 1000}}}
+	assert.Error(t, c.Write(&bytes.Buffer{}, "gif"))
+}
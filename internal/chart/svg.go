@@ -0,0 +1,96 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"unicode/utf8"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// legendLevels は凡例に表示する気圧レベルです。
+var legendLevels = []models.PressureLevelEnum{models.SlightAlert, models.Caution, models.Alert, models.SevereAlert}
+
+// WriteSVG は画像を SVG 形式で w に出力します。
+// 気圧レベルの帯と天気のアイコンには <title> を付けるため、ブラウザではマウスを重ねると名前が表示されます。
+func (c *PressureChart) WriteSVG(w io.Writer) error {
+	l := c.layout()
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="system-ui, -apple-system, 'Hiragino Sans', 'Noto Sans JP', sans-serif">`+"\n",
+		l.width, l.height, l.width, l.height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", hexColor(backgroundColor))
+	fmt.Fprintf(&b, `<text x="%d" y="24" font-size="16" font-weight="bold" fill="%s">%s</text>`+"\n", marginLeft, hexColor(textColor), html.EscapeString(c.Title))
+
+	// 凡例は右上に、右端から詰めて表示する
+	x := l.right
+	for i := len(legendLevels) - 1; i >= 0; i-- {
+		level := legendLevels[i]
+		name := level.String()
+		x -= float64(14 + 12*utf8.RuneCountInString(name))
+		fmt.Fprintf(&b, `<rect x="%.1f" y="13" width="10" height="10" fill="%s" fill-opacity="%g"/>`, x, hexColor(levelBandColors[level]), bandOpacity)
+		fmt.Fprintf(&b, `<text x="%.1f" y="22" font-size="11" fill="%s">%s</text>`+"\n", x+14, hexColor(textColor), html.EscapeString(name))
+		x -= 10
+	}
+
+	for _, band := range l.bands {
+		fmt.Fprintf(&b, `<rect class="level-%s" x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s" fill-opacity="%g"><title>%s</title></rect>`+"\n",
+			string(band.level), band.x0, l.top, band.x1-band.x0, l.base-l.top, hexColor(band.color), bandOpacity, html.EscapeString(band.level.String()))
+	}
+
+	for _, v := range l.yTicks {
+		y := l.yOf(v)
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s"/>`, l.left, y, l.right, y, hexColor(gridColor))
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" text-anchor="end" fill="%s">%s</text>`+"\n", l.left-6, y+4, hexColor(textColor), formatPressure(v))
+	}
+	fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" text-anchor="end" fill="%s">hPa</text>`+"\n", l.left-6, l.top-24, hexColor(textColor))
+	for _, tick := range l.xTicks {
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s"/>`, tick.x, l.base, tick.x, l.base+4, hexColor(axisColor))
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" text-anchor="middle" fill="%s">%s</text>`+"\n", tick.x, l.base+16, hexColor(textColor), tick.label)
+	}
+	fmt.Fprintf(&b, `<path d="M%.1f %.1fV%.1fH%.1f" fill="none" stroke="%s"/>`+"\n", l.left, l.top, l.base, l.right, hexColor(axisColor))
+
+	for _, line := range l.lines {
+		b.WriteString(`<polyline class="pressure" points="`)
+		for i, p := range line {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprintf(&b, "%.1f,%.1f", p.x, p.y)
+		}
+		fmt.Fprintf(&b, `" fill="none" stroke="%s" stroke-width="2" stroke-linejoin="round"/>`+"\n", hexColor(lineColor))
+	}
+
+	for _, icon := range l.icons {
+		fmt.Fprintf(&b, `<g class="weather"><title>%s</title>`, html.EscapeString(icon.weather.String()))
+		for _, s := range iconShapes(icon.kind, icon.x, icon.y) {
+			switch s.kind {
+			case shapeCircle:
+				fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="%s"/>`, s.x1, s.y1, s.r, hexColor(s.fill))
+			case shapeLine:
+				fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="1.5" stroke-linecap="round"/>`, s.x1, s.y1, s.x2, s.y2, hexColor(s.stroke))
+			}
+		}
+		b.WriteString("</g>\n")
+	}
+
+	if l.showNow {
+		fmt.Fprintf(&b, `<line class="now" x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="1.5" stroke-dasharray="4 3"/>`,
+			l.nowX, l.top, l.nowX, l.base, hexColor(nowColor))
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" fill="%s">%s</text>`+"\n", l.nowX+4, l.top+12, hexColor(nowColor), html.EscapeString(i18n.T("現在")))
+	}
+	b.WriteString("</svg>\n")
+
+	if _, err := b.WriteTo(w); err != nil {
+		return i18n.Errorf("SVG の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// hexColor は色を SVG の "#rrggbb" 形式に変換します。
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
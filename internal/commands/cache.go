@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/eraiza0816/zu2l/internal/cache"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// RunCacheClear は 'cache clear' コマンドの実行ロジックです。
+// キャッシュディレクトリ内の全てのキャッシュファイルを削除します。
+func RunCacheClear(store *cache.Store, cmd *cobra.Command, args []string) error {
+	removed, err := store.Clear()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), i18n.T("%d 件のキャッシュを削除しました (%s)\n"), removed, store.Dir)
+	return nil
+}
+
+// RunCacheStats は 'cache stats' コマンドの実行ロジックです。
+// エンドポイントごとのキャッシュ件数、期限切れ件数、サイズ、TTL を表示します。
+func RunCacheStats(store *cache.Store, cmd *cobra.Command, args []string) error {
+	stats, err := store.Stats()
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, i18n.T("キャッシュディレクトリ: %s\n"), stats.Dir)
+
+	table := tablewriter.NewWriter(out)
+	table.Header(i18n.T("エンドポイント"), "TTL", i18n.T("件数"), i18n.T("期限切れ"), i18n.T("サイズ (バイト)"))
+	for _, st := range stats.Endpoints {
+		ttl := "-"
+		if st.TTL > 0 {
+			ttl = st.TTL.String()
+		}
+		table.Append([]string{
+			st.Endpoint,
+			ttl,
+			strconv.Itoa(st.Entries),
+			strconv.Itoa(st.Expired),
+			strconv.FormatInt(st.Bytes, 10),
+		})
+	}
+	return table.Render()
+}
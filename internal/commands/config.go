@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// RunConfigShow は 'config show' コマンドの実行ロジックです。
+// 設定ファイル・環境変数・フラグを反映した、実際に使用される設定を YAML 形式で表示します。
+func RunConfigShow(cfg *config.Config, cmd *cobra.Command, args []string) error {
+	data, err := cfg.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = cmd.OutOrStdout().Write(data)
+	return err
+}
+
+// RunConfigSet は 'config set <key> <value>' コマンドの実行ロジックです。
+// 設定ファイルに明示的に書かれた値のみを読み込んで書き換えるため、デフォルト値や環境変数の値はファイルに書き込まれません。
+func RunConfigSet(path string, cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return i18n.Errorf("設定キーと値を指定してください (例: config set timeout 20s)")
+	}
+	key, value := args[0], args[1]
+
+	cfg, err := config.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Set(key, value); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), i18n.T("%s = %s を設定しました (%s)\n"), key, value, path)
+	return nil
+}
+
+// RunConfigPath は 'config path' コマンドの実行ロジックです。使用する設定ファイルのパスを表示します。
+func RunConfigPath(path string, cmd *cobra.Command, args []string) error {
+	fmt.Fprintln(cmd.OutOrStdout(), path)
+	return nil
+}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// targetArg はコマンドの対象を 引数 > 設定のデフォルト値 > デフォルトの地点 (@name) の順に決定します。
+// いずれも無い場合は ok が false になります。
+func targetArg(cfg *config.Config, args []string, defaultValue string) (target string, ok bool) {
+	if len(args) > 0 && args[0] != "" {
+		return args[0], true
+	}
+	if defaultValue != "" {
+		return defaultValue, true
+	}
+	if cfg.DefaultLocation != "" {
+		return config.LocationPrefix + cfg.DefaultLocation, true
+	}
+	return "", false
+}
+
+// resolveCityArg は @name 形式の引数を保存済み地点の地点コードに置き換えます。それ以外の引数はそのまま返します。
+func resolveCityArg(cfg *config.Config, arg string) (string, error) {
+	loc, ok, err := cfg.LookupLocation(arg)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return loc.City, nil
+	}
+	return arg, nil
+}
+
+// RunLocationAdd は 'location add <name> --city <code> [--area <code>]' コマンドの実行ロジックです。
+func RunLocationAdd(path string, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return i18n.Errorf("地点名を指定してください (例: location add home --city 13113)")
+	}
+	name := args[0]
+	city, _ := cmd.Flags().GetString("city")
+	area, _ := cmd.Flags().GetString("area")
+	setDefault, _ := cmd.Flags().GetBool("default")
+
+	cfg, err := config.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	loc := config.Location{City: city, Area: area}
+	if err := cfg.AddLocation(name, loc); err != nil {
+		return err
+	}
+	if setDefault {
+		cfg.DefaultLocation = name
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), i18n.T("地点 '%s' を登録しました (地点コード: %s, 地域コード: %s)\n"), name, loc.City, loc.AreaCode())
+	return nil
+}
+
+// RunLocationRemove は 'location remove <name>' コマンドの実行ロジックです。
+func RunLocationRemove(path string, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return i18n.Errorf("削除する地点名を指定してください")
+	}
+	cfg, err := config.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := cfg.RemoveLocation(args[0]); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), i18n.T("地点 '%s' を削除しました\n"), args[0])
+	return nil
+}
+
+// RunLocationDefault は 'location default [name]' コマンドの実行ロジックです。
+// 引数が無い場合は現在のデフォルトの地点を表示します。
+func RunLocationDefault(path string, cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		if cfg.DefaultLocation == "" {
+			fmt.Fprintln(cmd.OutOrStdout(), i18n.T("デフォルトの地点は設定されていません"))
+		} else {
+			fmt.Fprintln(cmd.OutOrStdout(), cfg.DefaultLocation)
+		}
+		return nil
+	}
+	if err := cfg.Set("default_location", args[0]); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), i18n.T("デフォルトの地点を '%s' に設定しました\n"), args[0])
+	return nil
+}
+
+// RunLocationList は 'location list' コマンドの実行ロジックです。保存済みの地点を一覧表示します。
+func RunLocationList(cfg *config.Config, cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+	names := cfg.LocationNames()
+	if len(names) == 0 {
+		fmt.Fprintln(out, i18n.T("登録されている地点はありません ('zutool location add <name> --city <code>' で登録できます)"))
+		return nil
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.Header(i18n.T("名前"), i18n.T("地点コード"), i18n.T("地域コード"), i18n.T("デフォルト"))
+	for _, name := range names {
+		loc := cfg.Locations[name]
+		mark := ""
+		if name == cfg.DefaultLocation {
+			mark = "*"
+		}
+		table.Append([]string{config.LocationPrefix + name, loc.City, loc.AreaCode(), mark})
+	}
+	return table.Render()
+}
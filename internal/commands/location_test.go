@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/config"
+)
+
+func TestTargetArg(t *testing.T) {
+	cfg := &config.Config{DefaultLocation: "home"}
+
+	target, ok := targetArg(cfg, []string{"13113"}, "27128")
+	assert.True(t, ok)
+	assert.Equal(t, "13113", target, "引数が最優先のはずです")
+
+	target, ok = targetArg(cfg, nil, "27128")
+	assert.True(t, ok)
+	assert.Equal(t, "27128", target, "引数が無い場合は設定のデフォルト値のはずです")
+
+	target, ok = targetArg(cfg, nil, "")
+	assert.True(t, ok)
+	assert.Equal(t, "@home", target, "デフォルト値も無い場合はデフォルトの地点のはずです")
+
+	_, ok = targetArg(&config.Config{}, nil, "")
+	assert.False(t, ok)
+}
+
+func TestResolveCityArg(t *testing.T) {
+	cfg := &config.Config{}
+	require.NoError(t, cfg.AddLocation("home", config.Location{City: "13113"}))
+
+	city, err := resolveCityArg(cfg, "@home")
+	require.NoError(t, err)
+	assert.Equal(t, "13113", city)
+
+	city, err = resolveCityArg(cfg, "27128")
+	require.NoError(t, err)
+	assert.Equal(t, "27128", city, "@ で始まらない引数はそのまま返すはずです")
+
+	_, err = resolveCityArg(cfg, "@office")
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/notify"
+
+	"github.com/spf13/cobra"
+)
+
+// NotifyContents は notify コマンドの --content で指定できる内容です。
+var NotifyContents = []string{"pain", "weather", "forecast"}
+
+// notifyClient は notify コマンドが使用する API クライアントのインターフェースです。
+type notifyClient interface {
+	ClientInterface
+	GetDailyForecastContext(ctx context.Context, cityCode string) ([]models.DailyForecast, error)
+}
+
+// buildNotifyReport は地点の痛み予報・気圧予報・週間予報を取得し、contents の順に Section を並べた Report を作成します。
+// 週間予報は Otenki ASP が対応している都市 (models.ConfirmedOtenkiAspCityCodeMap) のみ取得できます。
+func buildNotifyReport(ctx context.Context, client notifyClient, name string, loc config.Location, contents []string, dayOffset int) (notify.Report, error) {
+	report := notify.Report{Title: i18n.Sprintf("頭痛予報 (%s)", name)}
+	for _, content := range contents {
+		switch content {
+		case "pain":
+			city := loc.City
+			res, err := client.GetPainStatusContext(ctx, loc.AreaCode(), &city)
+			if err != nil {
+				return notify.Report{}, i18n.Errorf("痛み予報の取得に失敗しました: %w", err)
+			}
+			report.Sections = append(report.Sections, notify.PainStatusSection(res))
+		case "weather":
+			res, err := client.GetWeatherStatusContext(ctx, loc.City)
+			if err != nil {
+				return notify.Report{}, i18n.Errorf("気象状況の取得に失敗しました (%s): %w", loc.City, err)
+			}
+			section, err := notify.WeatherStatusSection(res, dayOffset)
+			if err != nil {
+				return notify.Report{}, err
+			}
+			report.Sections = append(report.Sections, section)
+		case "forecast":
+			cityName, ok := models.ConfirmedOtenkiAspCityCodeMap[loc.City]
+			if !ok {
+				return notify.Report{}, i18n.Errorf("地点コード %s の週間予報は取得できません (Otenki ASP が対応している都市のみ)", loc.City)
+			}
+			forecasts, err := client.GetDailyForecastContext(ctx, loc.City)
+			if err != nil {
+				return notify.Report{}, i18n.Errorf("Otenki ASP データの取得に失敗しました: %w", err)
+			}
+			report.Sections = append(report.Sections, notify.DailyForecastSection(cityName, forecasts))
+		default:
+			return notify.Report{}, i18n.Errorf("無効な内容です: %q (サポートされている値: %s)", content, strings.Join(NotifyContents, ", "))
+		}
+	}
+	return report, nil
+}
+
+// RunNotify は 'notify' コマンドの実行ロジック（アプリケーションサービス）です。
+// 地点の予報を Report にまとめ、--sink の形式で --url の Webhook に投稿します。--dry-run の場合は投稿せずにペイロードを出力します。
+func RunNotify(apiClient *api.Client, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("sink")
+	url, _ := cmd.Flags().GetString("url")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun && url == "" {
+		url = "http://localhost" // ペイロードの作成のみのため URL は使用しない
+	}
+	sink, err := notify.NewChatSink(format, url)
+	if err != nil {
+		return err
+	}
+
+	contents, _ := cmd.Flags().GetStringSlice("content")
+	if len(contents) == 0 {
+		return i18n.Errorf("--content で通知する内容を指定してください (サポートされている値: %s)", strings.Join(NotifyContents, ", "))
+	}
+	dayOffset, _ := cmd.Flags().GetInt("day")
+
+	name, loc, err := notifyTarget(cmd, apiClient, cfg, args)
+	if err != nil {
+		return err
+	}
+	report, err := buildNotifyReport(cmd.Context(), apiClient, name, loc, contents, dayOffset)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		body, err := sink.Payload(report)
+		if err != nil {
+			return err
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, body, "", "    "); err != nil {
+			return i18n.Errorf("ペイロードを整形できませんでした: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), indented.String())
+		return nil
+	}
+	if err := sink.Post(cmd.Context(), report); err != nil {
+		return i18n.Errorf("%s への投稿に失敗しました: %w", format, err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), i18n.T("%s に投稿しました。\n"), format)
+	return nil
+}
+
+// notifyTarget は引数から通知する地点を決定します。
+// @name 形式の場合は保存済みの地点を、それ以外は地点コードまたは地名 (地点検索で解決) を使用します。
+func notifyTarget(cmd *cobra.Command, client ClientInterface, cfg *config.Config, args []string) (string, config.Location, error) {
+	arg, ok := targetArg(cfg, args, cfg.DefaultCity)
+	if !ok {
+		return "", config.Location{}, i18n.Errorf("都市コードまたは @name を指定してください")
+	}
+	loc, isLocation, err := cfg.LookupLocation(arg)
+	if err != nil {
+		return "", config.Location{}, err
+	}
+	if isLocation {
+		return strings.TrimPrefix(arg, config.LocationPrefix), loc, nil
+	}
+
+	cityCode, err := resolveCityCode(cmd.Context(), client, arg, chooserFor(cmd))
+	if err != nil {
+		return "", config.Location{}, err
+	}
+	// 地域コードは地点コードの先頭2桁 (都道府県) を使う。設定の default_city を使う場合は default_area を優先する
+	loc = config.Location{City: cityCode}
+	if len(args) == 0 {
+		loc.Area = cfg.DefaultArea
+	}
+	if err := loc.Validate(); err != nil {
+		return "", config.Location{}, err
+	}
+	return arg, loc, nil
+}
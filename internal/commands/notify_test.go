@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/api/apitest"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestBuildNotifyReport(t *testing.T) {
+	mockClient := new(MockClient)
+	pain := models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{AreaName: "東京都", TimeStart: "12", TimeEnd: "17"}}
+	forecasts := []models.DailyForecast{{Date: time.Date(2025, 5, 22, 0, 0, 0, 0, models.JST), Weather: models.Sunny}}
+	mockClient.On("GetPainStatusContext", mock.Anything, "13", mock.Anything).Return(pain, nil)
+	mockClient.On("GetDailyForecastContext", mock.Anything, "13101").Return(forecasts, nil)
+
+	loc := config.Location{City: "13101"}
+	report, err := buildNotifyReport(context.Background(), mockClient, "home", loc, []string{"forecast", "pain"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "頭痛予報 (home)", report.Title)
+	require.Len(t, report.Sections, 2, "--content の順に Section が並ぶはずです")
+	assert.Equal(t, "東京の週間予報", report.Sections[0].Heading)
+	assert.Equal(t, "東京都の痛み予報", report.Sections[1].Heading)
+	mockClient.AssertExpectations(t)
+}
+
+func TestBuildNotifyReportWeather(t *testing.T) {
+	mockClient := new(MockClient)
+	weather := models.GetWeatherStatusResponse{PlaceName: "渋谷区", Today: []models.WeatherStatusByTime{{Hour: 0, Pressure: 1012, PressureLevel: models.Normal}}}
+	mockClient.On("GetWeatherStatusContext", mock.Anything, "13113").Return(weather, nil)
+
+	loc := config.Location{City: "13113"}
+	report, err := buildNotifyReport(context.Background(), mockClient, "13113", loc, []string{"weather"}, 0)
+	require.NoError(t, err)
+	require.Len(t, report.Sections, 1)
+	assert.Contains(t, report.Sections[0].Heading, "渋谷区の気圧予報")
+
+	_, err = buildNotifyReport(context.Background(), mockClient, "13113", loc, []string{"weather"}, 5)
+	assert.Error(t, err, "無効な日付オフセットはエラーになるはずです")
+}
+
+func TestBuildNotifyReportErrors(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetPainStatusContext", mock.Anything, "13", mock.Anything).Return(models.GetPainStatusResponse{}, errors.New("API client failed"))
+
+	loc := config.Location{City: "13113"}
+	_, err := buildNotifyReport(context.Background(), mockClient, "home", loc, []string{"forecast"}, 0)
+	assert.Error(t, err, "Otenki ASP が対応していない都市の週間予報はエラーになるはずです")
+
+	_, err = buildNotifyReport(context.Background(), mockClient, "home", loc, []string{"pain"}, 0)
+	assert.Error(t, err)
+
+	_, err = buildNotifyReport(context.Background(), mockClient, "home", loc, []string{"radar"}, 0)
+	assert.Error(t, err)
+	mockClient.AssertNotCalled(t, "GetDailyForecastContext", mock.Anything, mock.Anything)
+}
+
+func TestRunNotifyOutput(t *testing.T) {
+	server := apitest.NewServer(t)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer webhook.Close()
+
+	run := func(args ...string) (string, string) {
+		var stdout, stderr bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.Flags().String("sink", "", "")
+		cmd.Flags().String("url", "", "")
+		cmd.Flags().StringSlice("content", []string{"pain"}, "")
+		cmd.Flags().Int("day", 0, "")
+		cmd.Flags().Bool("dry-run", false, "")
+		require.NoError(t, cmd.ParseFlags(args))
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+		cmd.SetContext(context.Background())
+		require.NoError(t, RunNotify(server.Client(), &config.Config{}, cmd, []string{"13113"}))
+		return stdout.String(), stderr.String()
+	}
+
+	stdout, stderr := run("--sink", "slack", "--dry-run")
+	assert.True(t, json.Valid([]byte(stdout)), "ペイロードはコマンドの標準出力に出力されるはずです: %q", stdout)
+	assert.Empty(t, stderr)
+
+	stdout, stderr = run("--sink", "slack", "--url", webhook.URL)
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "slack に投稿しました。", "投稿結果はコマンドの標準エラー出力に出力されるはずです")
+}
@@ -2,9 +2,13 @@ package commands
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 	"github.com/eraiza0816/zu2l/internal/presenter"
 
@@ -12,8 +16,17 @@ import (
 )
 
 // RunOtenkiAsp は 'otenki_asp' コマンドの実行ロジック（アプリケーションサービス）です。
-func RunOtenkiAsp(client *api.Client, pres presenter.Presenter, cmd *cobra.Command, args []string) error {
-	cityArg := args[0]
+// 引数が @name 形式の場合は保存済み地点の地点コードを使用します。
+// 対応表に無い地名は地点検索で地点コードに解決しますが、Otenki ASP が対応している都市である必要があります。
+func RunOtenkiAsp(client *api.Client, pres presenter.Presenter, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	cityArg, ok := targetArg(cfg, args, cfg.DefaultCity)
+	if !ok {
+		return i18n.Errorf("都市コードまたは都市名を指定してください")
+	}
+	cityArg, err := resolveCityArg(cfg, cityArg)
+	if err != nil {
+		return err
+	}
 	nFlag, _ := cmd.Flags().GetIntSlice("n")
 
 	// 「東京」が指定された場合のデフォルト処理
@@ -38,26 +51,49 @@ func RunOtenkiAsp(client *api.Client, pres presenter.Presenter, cmd *cobra.Comma
 			}
 		}
 	}
+	if !found && !isNumeric(cityArg) {
+		// 対応表に無い地名 (例: 渋谷) は地点検索で地点コードに解決してから対応表を確認する
+		code, err := resolveCityCode(cmd.Context(), client, cityArg, chooserFor(cmd))
+		if err != nil {
+			return err
+		}
+		if name, ok := models.ConfirmedOtenkiAspCityCodeMap[code]; ok {
+			cityCode, cityName, found = code, name, true
+		} else {
+			cityArg = fmt.Sprintf("%s (%s)", cityArg, code)
+		}
+	}
 	if !found {
 		supportedValues := make([]string, 0, len(models.ConfirmedOtenkiAspCityCodeMap)*2)
 		for code, name := range models.ConfirmedOtenkiAspCityCodeMap {
 			supportedValues = append(supportedValues, code, name)
 		}
 		sort.Strings(supportedValues)
-		return fmt.Errorf("無効な都市コードまたは都市名です: '%s' (サポートされている値: %v)", cityArg, supportedValues)
+		return i18n.Errorf("無効な都市コードまたは都市名です: '%s' (サポートされている値: %v)", cityArg, supportedValues)
 	}
 
+	opts, err := otenkiASPOptionsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	if !cmd.Flags().Changed("n") {
+		// -n が省略された場合は取得した期間を全て表示する
+		nFlag = nFlag[:0]
+		for n := 0; n < opts.Duration; n++ {
+			nFlag = append(nFlag, n)
+		}
+	}
 	for _, n := range nFlag {
-		// Otenki ASP は 0 (今日) から 6 (6日後) までをサポート
-		if n < 0 || n > 6 {
-			return fmt.Errorf("無効な日付オフセットです: %d (0 から 6 の間で指定してください)", n)
+		// Otenki ASP は 0 (今日) から 予報日数-1 日後までをサポート
+		if n < 0 || n >= opts.Duration {
+			return i18n.Errorf("無効な日付オフセットです: %d (0 から %d の間で指定してください)", n, opts.Duration-1)
 		}
 	}
 	sort.Ints(nFlag)
 
-	res, err := client.GetOtenkiASP(cityCode)
+	res, err := client.GetOtenkiASPWithOptionsContext(cmd.Context(), cityCode, opts)
 	if err != nil {
-		return fmt.Errorf("Otenki ASP データの取得に失敗しました: %w", err)
+		return i18n.Errorf("Otenki ASP データの取得に失敗しました: %w", err)
 	}
 
 	var availableDates []time.Time
@@ -70,7 +106,7 @@ func RunOtenkiAsp(client *api.Client, pres presenter.Presenter, cmd *cobra.Comma
 			return availableDates[i].Before(availableDates[j])
 		})
 	} else {
-		fmt.Println("利用可能な日付データがありません。")
+		fmt.Fprintln(cmd.ErrOrStderr(), i18n.T("利用可能な日付データがありません。"))
 		return nil // 表示するものがないだけなので正常終了
 	}
 
@@ -93,8 +129,27 @@ func RunOtenkiAsp(client *api.Client, pres presenter.Presenter, cmd *cobra.Comma
 
 	err = pres.PresentOtenkiASP(res, targetDates, cityName, cityCode)
 	if err != nil {
-		return fmt.Errorf("結果の表示に失敗しました: %w", err)
+		return i18n.Errorf("結果の表示に失敗しました: %w", err)
 	}
 
 	return nil
 }
+
+// otenkiASPOptionsFromFlags は --contents と --duration フラグから api.OtenkiASPOptions を作成します。
+// 未知のコンテンツIDもそのまま Otenki ASP に要求しますが、表示名と単位は models.OtenkiContents の定義が無いため ID のまま表示されます。
+func otenkiASPOptionsFromFlags(cmd *cobra.Command) (api.OtenkiASPOptions, error) {
+	contents, _ := cmd.Flags().GetStringSlice("contents")
+	duration, _ := cmd.Flags().GetInt("duration")
+	if duration < 1 {
+		return api.OtenkiASPOptions{}, i18n.Errorf("無効な予報日数です: %d (1 以上を指定してください)", duration)
+	}
+
+	var contentIDs []string
+	for _, id := range contents {
+		id = strings.TrimSpace(id)
+		if id != "" && !slices.Contains(contentIDs, id) {
+			contentIDs = append(contentIDs, id)
+		}
+	}
+	return api.OtenkiASPOptions{ContentIDs: contentIDs, Duration: duration}, nil
+}
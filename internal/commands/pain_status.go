@@ -1,9 +1,12 @@
 package commands
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"github.com/eraiza0816/zu2l/api" // 実際のapiパッケージへのパス
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 	"github.com/eraiza0816/zu2l/internal/presenter"
 
@@ -12,11 +15,12 @@ import (
 
 // ClientInterface は API クライアントが満たすべきインターフェースを定義します。
 // これにより、テスト時にモックを注入できます。
+// 各メソッドは context.Context を受け取り、Ctrl-C などによるキャンセルを API 呼び出しに伝播します。
 type ClientInterface interface {
-	GetPainStatus(areaCode string, setWeatherPoint *string) (models.GetPainStatusResponse, error)
-	GetWeatherPoint(keyword string) (models.GetWeatherPointResponse, error)
-	GetWeatherStatus(cityCode string) (models.GetWeatherStatusResponse, error) // Added for weather_status
-	// GetOtenkiASP(cityCode string) (models.GetOtenkiASPResponse, error)
+	GetPainStatusContext(ctx context.Context, areaCode string, setWeatherPoint *string) (models.GetPainStatusResponse, error)
+	GetWeatherPointContext(ctx context.Context, keyword string) (models.GetWeatherPointResponse, error)
+	GetWeatherStatusContext(ctx context.Context, cityCode string) (models.GetWeatherStatusResponse, error) // Added for weather_status
+	// GetOtenkiASPContext(ctx context.Context, cityCode string) (models.GetOtenkiASPResponse, error)
 }
 
 // PresenterInterface はプレゼンターが満たすべきインターフェースを定義します。
@@ -24,32 +28,57 @@ type ClientInterface interface {
 type PresenterInterface interface {
 	PresentPainStatus(data models.GetPainStatusResponse) error
 	PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error
-	PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffset int, dayName string) error // Added for weather_status
+	PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error // Added for weather_status
+	PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error
 	// PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error
 }
 
 // runPainStatusLogic は痛み予報取得と表示のコアロジックを担当します。
 // 依存関係はインターフェースを通じて注入されます。
-func runPainStatusLogic(client ClientInterface, pres PresenterInterface, areaCode string, weatherPoint *string) error {
-	res, err := client.GetPainStatus(areaCode, weatherPoint)
+func runPainStatusLogic(ctx context.Context, client ClientInterface, pres PresenterInterface, areaCode string, weatherPoint *string) error {
+	res, err := client.GetPainStatusContext(ctx, areaCode, weatherPoint)
 	if err != nil {
-		return fmt.Errorf("痛み予報の取得に失敗しました: %w", err)
+		return i18n.Errorf("痛み予報の取得に失敗しました: %w", err)
 	}
 
 	err = pres.PresentPainStatus(res)
 	if err != nil {
-		return fmt.Errorf("結果の表示に失敗しました: %w", err)
+		return i18n.Errorf("結果の表示に失敗しました: %w", err)
 	}
 	return nil
 }
 
 // RunPainStatus は 'pain_status' コマンドの実行ロジック（アプリケーションサービス）です。
 // cobra.Command から引数をパースし、コアロジック関数を呼び出します。
-func RunPainStatus(apiClient *api.Client, actualPresenter presenter.Presenter, cmd *cobra.Command, args []string) error {
-	if len(args) == 0 {
-		return fmt.Errorf("地域コードまたは地域名を指定してください")
+// 引数が @name 形式の場合は保存済み地点の地域コードを使用し、--set_weather_point が無ければ地点コードも設定します。
+// --set_weather_point には地点コードの他に @name や地名 (地点検索で解決) も指定できます。
+func RunPainStatus(apiClient *api.Client, actualPresenter presenter.Presenter, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	areaArg, ok := targetArg(cfg, args, cfg.DefaultArea)
+	if !ok {
+		return i18n.Errorf("地域コードまたは地域名を指定してください")
+	}
+	setWeatherPointFlag, _ := cmd.Flags().GetString("set_weather_point")
+	setWeatherPointFlag, err := resolveCityArg(cfg, setWeatherPointFlag)
+	if err != nil {
+		return err
+	}
+	if setWeatherPointFlag != "" {
+		// 地名 (例: 渋谷) が指定された場合は地点検索で地点コードに解決する
+		setWeatherPointFlag, err = resolveCityCode(cmd.Context(), apiClient, setWeatherPointFlag, chooserFor(cmd))
+		if err != nil {
+			return err
+		}
+	}
+	loc, isLocation, err := cfg.LookupLocation(areaArg)
+	if err != nil {
+		return err
+	}
+	if isLocation {
+		areaArg = loc.AreaCode()
+		if setWeatherPointFlag == "" {
+			setWeatherPointFlag = loc.City
+		}
 	}
-	areaArg := args[0]
 	var areaCode string
 
 	// 地域コードまたは地域名から areaCode を解決
@@ -60,12 +89,11 @@ func RunPainStatus(apiClient *api.Client, actualPresenter presenter.Presenter, c
 	} else {
 		code, ok := models.AreaCodeMap[areaArg] // 地域名 (例: "東京")
 		if !ok {
-			return fmt.Errorf("無効な地域コードまたは地域名です: %s。有効な地域名（例：東京、大阪）または6桁の地域コード（例：130010）、または2桁の都道府県コード（例：13）を指定してください", areaArg)
+			return i18n.Errorf("無効な地域コードまたは地域名です: %s。有効な地域名（例：東京、大阪）または6桁の地域コード（例：130010）、または2桁の都道府県コード（例：13）を指定してください", areaArg)
 		}
 		areaCode = code
 	}
 
-	setWeatherPointFlag, _ := cmd.Flags().GetString("set_weather_point")
 	var setWeatherPoint *string
 	if setWeatherPointFlag != "" {
 		setWeatherPoint = &setWeatherPointFlag
@@ -103,5 +131,5 @@ func RunPainStatus(apiClient *api.Client, actualPresenter presenter.Presenter, c
                                                 // `presenter.Presenter` が `PresentPainStatus` メソッドを持っていると期待できます。
                                                 // その場合、`PresenterInterface` の定義をそれに合わせるのが適切かもしれません。
 
-	return runPainStatusLogic(apiClient, pWrapper, areaCode, setWeatherPoint)
+	return runPainStatusLogic(cmd.Context(), apiClient, pWrapper, areaCode, setWeatherPoint)
 }
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	// "flag" // No longer needed for urfave/cli context
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	// "github.com/urfave/cli/v2" // No longer needed for urfave/cli context
 
+	"github.com/eraiza0816/zu2l/internal/analysis"
 	"github.com/eraiza0816/zu2l/internal/models"
 	// "zutool/internal/presenter" // We use commands.PresenterInterface. This might also need updating if presenter is used directly.
 )
@@ -19,24 +21,30 @@ type MockClient struct {
 	mock.Mock
 }
 
-// GetPainStatus is a mock method
-func (m *MockClient) GetPainStatus(areaCode string, setWeatherPoint *string) (models.GetPainStatusResponse, error) {
-	args := m.Called(areaCode, setWeatherPoint)
+// GetPainStatusContext is a mock method
+func (m *MockClient) GetPainStatusContext(ctx context.Context, areaCode string, setWeatherPoint *string) (models.GetPainStatusResponse, error) {
+	args := m.Called(ctx, areaCode, setWeatherPoint)
 	return args.Get(0).(models.GetPainStatusResponse), args.Error(1)
 }
 
-// GetWeatherPoint is a mock method (added for weather_point)
-func (m *MockClient) GetWeatherPoint(keyword string) (models.GetWeatherPointResponse, error) {
-	args := m.Called(keyword)
+// GetWeatherPointContext is a mock method (added for weather_point)
+func (m *MockClient) GetWeatherPointContext(ctx context.Context, keyword string) (models.GetWeatherPointResponse, error) {
+	args := m.Called(ctx, keyword)
 	return args.Get(0).(models.GetWeatherPointResponse), args.Error(1)
 }
 
-// GetWeatherStatus is a mock method (added for weather_status)
-func (m *MockClient) GetWeatherStatus(cityCode string) (models.GetWeatherStatusResponse, error) {
-	args := m.Called(cityCode)
+// GetWeatherStatusContext is a mock method (added for weather_status)
+func (m *MockClient) GetWeatherStatusContext(ctx context.Context, cityCode string) (models.GetWeatherStatusResponse, error) {
+	args := m.Called(ctx, cityCode)
 	return args.Get(0).(models.GetWeatherStatusResponse), args.Error(1)
 }
 
+// GetDailyForecastContext is a mock method (added for notify)
+func (m *MockClient) GetDailyForecastContext(ctx context.Context, cityCode string) ([]models.DailyForecast, error) {
+	args := m.Called(ctx, cityCode)
+	return args.Get(0).([]models.DailyForecast), args.Error(1)
+}
+
 // Ensure MockClient implements commands.ClientInterface
 var _ ClientInterface = (*MockClient)(nil)
 
@@ -63,8 +71,14 @@ func (m *MockPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse,
 }
 
 // PresentWeatherStatus is a mock method (added for weather_status)
-func (m *MockPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffset int, dayName string) error {
-	args := m.Called(data, dayOffset, dayName)
+func (m *MockPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	args := m.Called(data, dayOffsets)
+	return args.Error(0)
+}
+
+// PresentPressureAnalysis is a mock method (added for pressure_analysis)
+func (m *MockPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	args := m.Called(data, hourly)
 	return args.Error(0)
 }
 
@@ -93,10 +107,10 @@ func TestRunPainStatusLogic_Success(t *testing.T) {
 	}
 
 	// Setup expectations
-	mockClient.On("GetPainStatus", areaCode, weatherPoint).Return(expectedResponse, nil)
+	mockClient.On("GetPainStatusContext", mock.Anything, areaCode, weatherPoint).Return(expectedResponse, nil)
 	mockPresenter.On("PresentPainStatus", expectedResponse).Return(nil)
 
-	err := runPainStatusLogic(mockClient, mockPresenter, areaCode, weatherPoint)
+	err := runPainStatusLogic(context.Background(), mockClient, mockPresenter, areaCode, weatherPoint)
 	assert.NoError(t, err)
 
 	// Verify that the expected methods were called
@@ -112,10 +126,10 @@ func TestRunPainStatusLogic_ClientError(t *testing.T) {
 	var weatherPoint *string = nil
 	clientError := errors.New("API error")
 
-	mockClient.On("GetPainStatus", areaCode, weatherPoint).Return(models.GetPainStatusResponse{}, clientError)
+	mockClient.On("GetPainStatusContext", mock.Anything, areaCode, weatherPoint).Return(models.GetPainStatusResponse{}, clientError)
 	// Presenter.PresentPainStatus should not be called
 
-	err := runPainStatusLogic(mockClient, mockPresenter, areaCode, weatherPoint)
+	err := runPainStatusLogic(context.Background(), mockClient, mockPresenter, areaCode, weatherPoint)
 
 	assert.Error(t, err)
 	assert.EqualError(t, err, "痛み予報の取得に失敗しました: API error") // Error is wrapped
@@ -137,10 +151,10 @@ func TestRunPainStatusLogic_PresenterError(t *testing.T) {
 	}
 	presenterError := errors.New("presenter failed")
 
-	mockClient.On("GetPainStatus", areaCode, weatherPoint).Return(expectedResponse, nil)
+	mockClient.On("GetPainStatusContext", mock.Anything, areaCode, weatherPoint).Return(expectedResponse, nil)
 	mockPresenter.On("PresentPainStatus", expectedResponse).Return(presenterError)
 
-	err := runPainStatusLogic(mockClient, mockPresenter, areaCode, weatherPoint)
+	err := runPainStatusLogic(context.Background(), mockClient, mockPresenter, areaCode, weatherPoint)
 
 	assert.Error(t, err)
 	assert.EqualError(t, err, "結果の表示に失敗しました: presenter failed") // Error is wrapped
@@ -164,10 +178,33 @@ func TestRunPainStatusLogic_WithWeatherPoint(t *testing.T) {
 	}
 
 	// Setup expectations for GetPainStatus with weatherPoint
-	mockClient.On("GetPainStatus", areaCode, weatherPoint).Return(expectedResponse, nil)
+	mockClient.On("GetPainStatusContext", mock.Anything, areaCode, weatherPoint).Return(expectedResponse, nil)
+	mockPresenter.On("PresentPainStatus", expectedResponse).Return(nil)
+
+	err := runPainStatusLogic(context.Background(), mockClient, mockPresenter, areaCode, weatherPoint)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+	mockPresenter.AssertExpectations(t)
+}
+
+func TestRunPainStatusLogic_PropagatesContext(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := NewMockPresenter()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	areaCode := "13"
+	var weatherPoint *string = nil
+	expectedResponse := models.GetPainStatusResponse{
+		PainnoterateStatus: models.GetPainStatus{AreaName: "東京都"},
+	}
+
+	// 呼び出し元の ctx がそのままクライアントに渡されることを確認
+	mockClient.On("GetPainStatusContext", ctx, areaCode, weatherPoint).Return(expectedResponse, nil)
 	mockPresenter.On("PresentPainStatus", expectedResponse).Return(nil)
 
-	err := runPainStatusLogic(mockClient, mockPresenter, areaCode, weatherPoint)
+	err := runPainStatusLogic(ctx, mockClient, mockPresenter, areaCode, weatherPoint)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/presenter"
+
+	"github.com/spf13/cobra"
+)
+
+// pressureLevels は --level で指定できる気圧レベルです。
+var pressureLevels = []models.PressureLevelEnum{models.Normal, models.SlightAlert, models.Caution, models.Alert, models.SevereAlert}
+
+// runPressureAnalysisLogic は気象状況を取得し、気圧変化を分析して表示するコアロジックです。
+// 依存関係はインターフェースを通じて注入されます。
+func runPressureAnalysisLogic(ctx context.Context, client ClientInterface, pres PresenterInterface, cityCode string, opts analysis.Options, hourly bool) error {
+	res, err := client.GetWeatherStatusContext(ctx, cityCode)
+	if err != nil {
+		return i18n.Errorf("気象状況の取得に失敗しました (%s): %w", cityCode, err)
+	}
+
+	err = pres.PresentPressureAnalysis(analysis.AnalyzePressure(res, opts), hourly)
+	if err != nil {
+		return i18n.Errorf("結果の表示に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// RunPressureAnalysis は 'pressure_analysis' コマンドの実行ロジック（アプリケーションサービス）です。
+// 地点の指定方法は weather_status と同じで、@name や地名も使用できます。
+func RunPressureAnalysis(apiClient *api.Client, actualPresenter presenter.Presenter, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	cityArg, ok := targetArg(cfg, args, cfg.DefaultCity)
+	if !ok {
+		return i18n.Errorf("都市コードを指定してください")
+	}
+	cityCode, err := resolveCityArg(cfg, cityArg)
+	if err != nil {
+		return err
+	}
+	cityCode, err = resolveCityCode(cmd.Context(), apiClient, cityCode, chooserFor(cmd))
+	if err != nil {
+		return err
+	}
+
+	opts, err := analysisOptionsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	hourly, _ := cmd.Flags().GetBool("hourly")
+
+	return runPressureAnalysisLogic(cmd.Context(), apiClient, actualPresenter, cityCode, opts, hourly)
+}
+
+// analysisOptionsFromFlags は -n, --window, --threshold, --level フラグから analysis.Options を作成します。
+func analysisOptionsFromFlags(cmd *cobra.Command) (analysis.Options, error) {
+	nFlag, _ := cmd.Flags().GetIntSlice("n")
+	for _, n := range nFlag {
+		if n < -1 || n > 2 {
+			return analysis.Options{}, i18n.Errorf("無効な日付オフセットです: %d (-1 から 2 の間で指定してください)", n)
+		}
+	}
+	sort.Ints(nFlag)
+	nFlag = slices.Compact(nFlag)
+
+	window, _ := cmd.Flags().GetInt("window")
+	if window < 1 || window > 24 {
+		return analysis.Options{}, i18n.Errorf("無効な期間です: %d (1 から 24 の間で指定してください)", window)
+	}
+	threshold, _ := cmd.Flags().GetFloat64("threshold")
+	if threshold <= 0 {
+		return analysis.Options{}, i18n.Errorf("無効な気圧低下量です: %g (0 より大きい値を指定してください)", threshold)
+	}
+	level, _ := cmd.Flags().GetString("level")
+	if !slices.Contains(pressureLevels, models.PressureLevelEnum(level)) {
+		return analysis.Options{}, i18n.Errorf("無効な気圧レベルです: %q (サポートされている値: %v)", level, pressureLevels)
+	}
+
+	return analysis.Options{
+		DayOffsets:    nFlag,
+		DropWindow:    window,
+		DropThreshold: threshold,
+		RiskLevel:     models.PressureLevelEnum(level),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestRunPressureAnalysisLogic_Success(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := new(MockPresenter)
+
+	cityCode := "13113"
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	response := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		Today: []models.WeatherStatusByTime{
+			{Hour: 0, Pressure: 1010, PressureLevel: models.Normal, At: today},
+			{Hour: 1, Pressure: 1009, PressureLevel: models.Caution, At: today.Add(time.Hour)},
+		},
+	}
+	opts := analysis.Options{DayOffsets: []int{0}}
+
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(response, nil)
+	mockPresenter.On("PresentPressureAnalysis", analysis.AnalyzePressure(response, opts), true).Return(nil)
+
+	err := runPressureAnalysisLogic(context.Background(), mockClient, mockPresenter, cityCode, opts, true)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+	mockPresenter.AssertExpectations(t)
+}
+
+func TestRunPressureAnalysisLogic_ClientError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := new(MockPresenter)
+
+	cityCode := "999999"
+	clientError := errors.New("API client failed")
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(models.GetWeatherStatusResponse{}, clientError)
+
+	err := runPressureAnalysisLogic(context.Background(), mockClient, mockPresenter, cityCode, analysis.Options{}, false)
+	assert.EqualError(t, err, fmt.Sprintf("気象状況の取得に失敗しました (%s): %s", cityCode, clientError.Error()))
+
+	mockClient.AssertExpectations(t)
+	mockPresenter.AssertNotCalled(t, "PresentPressureAnalysis", mock.Anything, mock.Anything)
+}
+
+func TestAnalysisOptionsFromFlags(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().IntSliceP("n", "n", []int{0, 1, 2}, "")
+		cmd.Flags().Int("window", analysis.DefaultDropWindow, "")
+		cmd.Flags().Float64("threshold", analysis.DefaultDropThreshold, "")
+		cmd.Flags().String("level", string(analysis.DefaultRiskLevel), "")
+		assert.NoError(t, cmd.ParseFlags(args))
+		return cmd
+	}
+
+	opts, err := analysisOptionsFromFlags(newCmd("-n", "1", "-n", "0", "-n", "1", "--level", "4"))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, opts.DayOffsets, "オフセットは昇順に並べ、重複を取り除くはずです")
+	assert.Equal(t, models.Alert, opts.RiskLevel)
+	assert.Equal(t, analysis.DefaultDropWindow, opts.DropWindow)
+
+	for _, args := range [][]string{
+		{"-n", "3"},
+		{"--window", "0"},
+		{"--threshold", "-1"},
+		{"--level", "1"},
+	} {
+		_, err := analysisOptionsFromFlags(newCmd(args...))
+		assert.Error(t, err, "%v はエラーになるはずです", args)
+	}
+}
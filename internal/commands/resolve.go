@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// candidateChooser は地名検索で複数の候補が見つかった場合に1つを選択する関数です。
+type candidateChooser func(keyword string, candidates []models.WeatherPoint) (models.WeatherPoint, error)
+
+// resolveCityCode は地点コードまたは地名 (例: "渋谷") を地点コードに解決します。
+// 数字のみの引数はそのまま地点コードとして扱い、それ以外は GetWeatherPoint で検索します。
+// 候補が1件の場合や地点名が完全一致する候補が1件の場合はそれを使用し、
+// それ以外で複数の候補がある場合は choose で選択します。choose が nil (非対話モード) の場合は候補一覧を含むエラーを返します。
+func resolveCityCode(ctx context.Context, client ClientInterface, arg string, choose candidateChooser) (string, error) {
+	if isNumeric(arg) {
+		return arg, nil
+	}
+
+	res, err := client.GetWeatherPointContext(ctx, arg)
+	if err != nil {
+		return "", i18n.Errorf("地点の検索に失敗しました (%s): %w", arg, err)
+	}
+	candidates := res.Result.Root
+	if len(candidates) == 0 {
+		return "", i18n.Errorf("'%s' に一致する地点が見つかりませんでした", arg)
+	}
+	if len(candidates) == 1 {
+		return candidates[0].CityCode, nil
+	}
+
+	var exact []models.WeatherPoint
+	for _, p := range candidates {
+		if p.Name == arg {
+			exact = append(exact, p)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0].CityCode, nil
+	}
+
+	if choose == nil {
+		return "", i18n.Errorf("'%s' に一致する地点が複数あります。地点コードで指定してください:\n%s", arg, formatCandidates(candidates))
+	}
+	p, err := choose(arg, candidates)
+	if err != nil {
+		return "", err
+	}
+	return p.CityCode, nil
+}
+
+// promptChooser は候補を番号付きで out に表示し、in から選択された番号を読み取る candidateChooser を返します。
+func promptChooser(in io.Reader, out io.Writer) candidateChooser {
+	reader := bufio.NewReader(in)
+	return func(keyword string, candidates []models.WeatherPoint) (models.WeatherPoint, error) {
+		fmt.Fprintf(out, i18n.T("'%s' に一致する地点が複数あります:\n%s"), keyword, formatCandidates(candidates))
+		for {
+			fmt.Fprintf(out, i18n.T("番号を選択してください (1-%d): "), len(candidates))
+			line, err := reader.ReadString('\n')
+			n, convErr := strconv.Atoi(strings.TrimSpace(line))
+			if convErr == nil && n >= 1 && n <= len(candidates) {
+				return candidates[n-1], nil
+			}
+			if err != nil {
+				return models.WeatherPoint{}, i18n.Errorf("地点が選択されませんでした: %w", err)
+			}
+			fmt.Fprintln(out, i18n.T("無効な番号です"))
+		}
+	}
+}
+
+// chooserFor はコマンドの標準入力が端末であれば対話的な candidateChooser を、そうでなければ nil を返します。
+func chooserFor(cmd *cobra.Command) candidateChooser {
+	f, ok := cmd.InOrStdin().(*os.File)
+	if !ok || !(isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())) {
+		return nil
+	}
+	return promptChooser(f, cmd.ErrOrStderr())
+}
+
+// formatCandidates は候補を番号付きの一覧文字列に整形します。
+func formatCandidates(candidates []models.WeatherPoint) string {
+	var b strings.Builder
+	for i, p := range candidates {
+		fmt.Fprintf(&b, "  %d) %s (%s)\n", i+1, p.Name, p.CityCode)
+	}
+	return b.String()
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// weatherPoints はテスト用の地点検索レスポンスを作成します。
+func weatherPoints(points ...models.WeatherPoint) models.GetWeatherPointResponse {
+	return models.GetWeatherPointResponse{Result: models.WeatherPoints{Root: points}}
+}
+
+func TestResolveCityCode_NumericSkipsSearch(t *testing.T) {
+	mockClient := new(MockClient)
+
+	code, err := resolveCityCode(context.Background(), mockClient, "13113", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "13113", code)
+	mockClient.AssertNotCalled(t, "GetWeatherPointContext", mock.Anything, mock.Anything)
+}
+
+func TestResolveCityCode_UniqueMatch(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "渋谷").Return(weatherPoints(
+		models.WeatherPoint{CityCode: "13113", Name: "東京都渋谷区"},
+	), nil)
+
+	code, err := resolveCityCode(context.Background(), mockClient, "渋谷", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "13113", code)
+}
+
+func TestResolveCityCode_ExactNameMatch(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "府中市").Return(weatherPoints(
+		models.WeatherPoint{CityCode: "13206", Name: "府中市"},
+		models.WeatherPoint{CityCode: "34208", Name: "府中市 (広島県)"},
+	), nil)
+
+	code, err := resolveCityCode(context.Background(), mockClient, "府中市", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "13206", code, "地点名が完全一致する候補が選ばれるはずです")
+}
+
+func TestResolveCityCode_AmbiguousNonInteractive(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "中央").Return(weatherPoints(
+		models.WeatherPoint{CityCode: "13102", Name: "東京都中央区"},
+		models.WeatherPoint{CityCode: "27128", Name: "大阪府大阪市中央区"},
+	), nil)
+
+	_, err := resolveCityCode(context.Background(), mockClient, "中央", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "東京都中央区 (13102)", "エラーに候補一覧が含まれるはずです")
+	assert.Contains(t, err.Error(), "大阪府大阪市中央区 (27128)")
+}
+
+func TestResolveCityCode_AmbiguousInteractive(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "中央").Return(weatherPoints(
+		models.WeatherPoint{CityCode: "13102", Name: "東京都中央区"},
+		models.WeatherPoint{CityCode: "27128", Name: "大阪府大阪市中央区"},
+	), nil)
+
+	var out strings.Builder
+	choose := promptChooser(strings.NewReader("9\n2\n"), &out)
+	code, err := resolveCityCode(context.Background(), mockClient, "中央", choose)
+	require.NoError(t, err)
+	assert.Equal(t, "27128", code)
+	assert.Contains(t, out.String(), "無効な番号です", "範囲外の番号は再入力を求めるはずです")
+}
+
+func TestResolveCityCode_NoMatchOrError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "存在しない").Return(weatherPoints(), nil)
+	mockClient.On("GetWeatherPointContext", mock.Anything, "エラー").Return(models.GetWeatherPointResponse{}, errors.New("API error"))
+
+	_, err := resolveCityCode(context.Background(), mockClient, "存在しない", nil)
+	assert.ErrorContains(t, err, "見つかりませんでした")
+
+	_, err = resolveCityCode(context.Background(), mockClient, "エラー", nil)
+	assert.ErrorContains(t, err, "API error")
+}
+
+func TestPromptChooser_EOF(t *testing.T) {
+	choose := promptChooser(strings.NewReader(""), io.Discard)
+	_, err := choose("中央", []models.WeatherPoint{{CityCode: "13102"}, {CityCode: "27128"}})
+	assert.Error(t, err, "入力が終了した場合はエラーになるはずです")
+}
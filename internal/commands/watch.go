@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/notify"
+
+	"github.com/spf13/cobra"
+)
+
+// watchTarget は watch コマンドで監視する地点です。
+type watchTarget struct {
+	Name     string // 通知に表示する地点名
+	Location config.Location
+}
+
+// watchTargets は引数の地点名 (@ は省略可) から監視する地点を決定します。
+// 引数が無い場合は保存済みの全地点、保存済みの地点も無い場合は設定の default_city を監視します。
+func watchTargets(cfg *config.Config, args []string) ([]watchTarget, error) {
+	names := args
+	if len(names) == 0 {
+		names = cfg.LocationNames()
+	}
+	if len(names) == 0 {
+		if cfg.DefaultCity == "" {
+			return nil, i18n.Errorf("監視する地点がありません。'zutool location add' で地点を登録するか、地点名を指定してください")
+		}
+		loc := config.Location{City: cfg.DefaultCity, Area: cfg.DefaultArea}
+		if err := loc.Validate(); err != nil {
+			return nil, err
+		}
+		return []watchTarget{{Name: cfg.DefaultCity, Location: loc}}, nil
+	}
+
+	targets := make([]watchTarget, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimPrefix(name, config.LocationPrefix)
+		loc, err := cfg.Location(name)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, watchTarget{Name: name, Location: loc})
+	}
+	return targets, nil
+}
+
+// runWatchCycle は全ての地点の気圧予報と痛み予報を1回取得し、検出した警報を通知します。
+// 一部の地点で取得に失敗しても残りの地点は処理し、エラーはまとめて返します。
+func runWatchCycle(ctx context.Context, client ClientInterface, notifier *notify.Notifier, targets []watchTarget, rules notify.Rules, now time.Time) error {
+	var events []notify.Event
+	var errs []error
+	for _, target := range targets {
+		weather, err := client.GetWeatherStatusContext(ctx, target.Location.City)
+		if err != nil {
+			errs = append(errs, i18n.Errorf("気象状況の取得に失敗しました (%s): %w", target.Name, err))
+		} else {
+			events = append(events, rules.PressureEvents(target.Name, weather, now)...)
+		}
+
+		if rules.PainfulRate > 0 || rules.BadRate > 0 {
+			city := target.Location.City
+			pain, err := client.GetPainStatusContext(ctx, target.Location.AreaCode(), &city)
+			if err != nil {
+				errs = append(errs, i18n.Errorf("痛み予報の取得に失敗しました (%s): %w", target.Name, err))
+			} else {
+				events = append(events, rules.PainEvents(target.Name, pain, now)...)
+			}
+		}
+	}
+
+	if _, err := notifier.Notify(ctx, events); err != nil {
+		errs = append(errs, i18n.Errorf("通知に失敗しました: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// RunWatch は 'watch' コマンドの実行ロジック（アプリケーションサービス）です。
+// --interval ごとに地点の予報を取得し、警報を --sink の通知先に送信します。Ctrl-C で終了します。
+// 取得や通知の失敗は標準エラー出力に表示して監視を続けます (--once の場合はエラーとして返します)。
+func RunWatch(apiClient *api.Client, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	targets, err := watchTargets(cfg, args)
+	if err != nil {
+		return err
+	}
+	rules, err := watchRulesFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval < time.Minute {
+		return i18n.Errorf("無効な間隔です: %s (1分以上を指定してください)", interval)
+	}
+	once, _ := cmd.Flags().GetBool("once")
+
+	specs, _ := cmd.Flags().GetStringArray("sink")
+	sinks := make([]notify.Sink, 0, len(specs))
+	for _, spec := range specs {
+		sink, err := notify.ParseSink(spec)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	statePath, _ := cmd.Flags().GetString("state")
+	notifier, err := notify.NewNotifier(sinks, statePath)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if once {
+		return runWatchCycle(ctx, apiClient, notifier, targets, rules, time.Now())
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := runWatchCycle(ctx, apiClient, notifier, targets, rules, time.Now()); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, i18n.T("警告: %v\n"), err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchRulesFromFlags は --within, --level, --painful, --bad フラグから notify.Rules を作成します。
+func watchRulesFromFlags(cmd *cobra.Command) (notify.Rules, error) {
+	within, _ := cmd.Flags().GetDuration("within")
+	if within <= 0 || within > 72*time.Hour {
+		return notify.Rules{}, i18n.Errorf("無効な期間です: %s (0 より大きく 72h 以下で指定してください)", within)
+	}
+	level, _ := cmd.Flags().GetString("level")
+	if !slices.Contains(pressureLevels, models.PressureLevelEnum(level)) {
+		return notify.Rules{}, i18n.Errorf("無効な気圧レベルです: %q (サポートされている値: %v)", level, pressureLevels)
+	}
+	painful, _ := cmd.Flags().GetFloat64("painful")
+	bad, _ := cmd.Flags().GetFloat64("bad")
+	if painful < 0 || painful > 100 || bad < 0 || bad > 100 {
+		return notify.Rules{}, i18n.Errorf("痛み予報の閾値は 0 から 100 の間で指定してください (0 で無効)")
+	}
+	return notify.Rules{Within: within, Level: models.PressureLevelEnum(level), PainfulRate: painful, BadRate: bad}, nil
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/notify"
+)
+
+// collectingSink は送信された Event を記録する notify.Sink です。
+type collectingSink struct {
+	events []notify.Event
+}
+
+func (s *collectingSink) Send(ctx context.Context, event notify.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestWatchTargets(t *testing.T) {
+	cfg := &config.Config{}
+	require.NoError(t, cfg.AddLocation("office", config.Location{City: "27128"}))
+	require.NoError(t, cfg.AddLocation("home", config.Location{City: "13113"}))
+
+	targets, err := watchTargets(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, targets, 2, "引数が無い場合は保存済みの全地点のはずです")
+	assert.Equal(t, "home", targets[0].Name)
+
+	targets, err = watchTargets(cfg, []string{"@office"})
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "27128", targets[0].Location.City)
+
+	_, err = watchTargets(cfg, []string{"school"})
+	assert.Error(t, err)
+
+	targets, err = watchTargets(&config.Config{DefaultCity: "13113"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "13", targets[0].Location.AreaCode(), "地点が無い場合は default_city を使うはずです")
+
+	_, err = watchTargets(&config.Config{}, nil)
+	assert.Error(t, err)
+}
+
+func TestRunWatchCycle(t *testing.T) {
+	mockClient := new(MockClient)
+	now := time.Date(2025, 5, 20, 9, 0, 0, 0, models.JST)
+	weather := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		Today: []models.WeatherStatusByTime{
+			{Hour: 10, PressureLevel: models.Alert, At: now.Add(time.Hour)},
+		},
+	}
+	pain := models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{TimeStart: "09", TimeEnd: "12", RatePainful: 60}}
+	mockClient.On("GetWeatherStatusContext", mock.Anything, "13113").Return(weather, nil)
+	mockClient.On("GetPainStatusContext", mock.Anything, "13", mock.Anything).Return(pain, nil)
+	mockClient.On("GetWeatherStatusContext", mock.Anything, "27128").Return(models.GetWeatherStatusResponse{}, errors.New("API client failed"))
+	mockClient.On("GetPainStatusContext", mock.Anything, "27", mock.Anything).Return(models.GetPainStatusResponse{}, nil)
+
+	sink := &collectingSink{}
+	notifier, err := notify.NewNotifier([]notify.Sink{sink}, "")
+	require.NoError(t, err)
+	targets := []watchTarget{
+		{Name: "home", Location: config.Location{City: "13113"}},
+		{Name: "office", Location: config.Location{City: "27128"}},
+	}
+
+	err = runWatchCycle(context.Background(), mockClient, notifier, targets, notify.DefaultRules, now)
+	assert.Error(t, err, "一部の地点の取得失敗はエラーとして返されるはずです")
+	require.Len(t, sink.events, 2, "失敗しなかった地点の警報は通知されるはずです")
+	assert.Equal(t, notify.KindPressureLevel, sink.events[0].Kind)
+	assert.Equal(t, notify.KindPainRate, sink.events[1].Kind)
+
+	// 2回目は同じ警報を通知しない
+	_ = runWatchCycle(context.Background(), mockClient, notifier, targets, notify.DefaultRules, now.Add(30*time.Minute))
+	assert.Len(t, sink.events, 2)
+	mockClient.AssertExpectations(t)
+}
@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"errors"
 	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models" // models をインポート
 	"github.com/eraiza0816/zu2l/internal/presenter"
 
@@ -16,18 +18,18 @@ var _ models.GetWeatherPointResponse
 // runWeatherPointLogic は地点検索のコアロジックを担当します。
 // 依存関係はインターフェースを通じて注入されます。
 // この関数シグネチャは weather_point_test.go の想定と一致させます。
-func runWeatherPointLogic(client ClientInterface, pres PresenterInterface, keyword string, kata bool) error {
-	res, err := client.GetWeatherPoint(keyword)
+func runWeatherPointLogic(ctx context.Context, client ClientInterface, pres PresenterInterface, keyword string, kata bool) error {
+	res, err := client.GetWeatherPointContext(ctx, keyword)
 	if err != nil {
 		// 404エラーの場合、APIクライアントは models.GetWeatherPointResponse{} とエラーを返す想定。
 		// エラーハンドリングは呼び出し元か、より上位の層で行う。
 		// ここではエラーをラップして返す。
-		return fmt.Errorf("地域地点の検索に失敗しました: %w", err)
+		return i18n.Errorf("地域地点の検索に失敗しました: %w", err)
 	}
 
 	err = pres.PresentWeatherPoint(res, kata, keyword)
 	if err != nil {
-		return fmt.Errorf("結果の表示に失敗しました: %w", err)
+		return i18n.Errorf("結果の表示に失敗しました: %w", err)
 	}
 	return nil
 }
@@ -36,7 +38,7 @@ func runWeatherPointLogic(client ClientInterface, pres PresenterInterface, keywo
 // cobra.Command から引数をパースし、コアロジック関数を呼び出します。
 func RunWeatherPoint(apiClient *api.Client, actualPresenter presenter.Presenter, cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("検索キーワードを指定してください")
+		return i18n.Errorf("検索キーワードを指定してください")
 	}
 	keyword := args[0]
 	kataFlag, _ := cmd.Flags().GetBool("kata")
@@ -53,11 +55,11 @@ func RunWeatherPoint(apiClient *api.Client, actualPresenter presenter.Presenter,
 		// ここでは、actualPresenter が PresenterInterface を満たさない場合にエラーとする。
 		// ただし、pain_status.go で定義した PresenterInterface は PresentPainStatus と PresentWeatherPoint を持つため、
 		// actualPresenter (presenter.Presenter型) がこれらのメソッドをすべて持っていればキャストは成功する。
-		return fmt.Errorf("内部エラー: プレゼンターが期待されるインターフェースを満たしていません")
+		return i18n.Errorf("内部エラー: プレゼンターが期待されるインターフェースを満たしていません")
 	}
 
 
-	err := runWeatherPointLogic(apiClient, pWrapper, keyword, kataFlag)
+	err := runWeatherPointLogic(cmd.Context(), apiClient, pWrapper, keyword, kataFlag)
 	if err != nil {
 		// 404 Not Found の場合の特別扱いをここで行うか検討
 		// 例えば、エラーメッセージの内容で判断する
@@ -68,9 +70,9 @@ func RunWeatherPoint(apiClient *api.Client, actualPresenter presenter.Presenter,
 		originalErr := errors.Unwrap(err)
 		var apiErr *api.APIError
 		if errors.As(originalErr, &apiErr) && apiErr.StatusCode == 404 {
-			// 404の場合はエラーメッセージを標準出力し、コマンドとしては成功扱い (nilを返す)
+			// 404の場合はエラーメッセージを標準エラー出力し、コマンドとしては成功扱い (nilを返す)
 			// この動作はテストで別途検証するか、プレゼンターに責務を移すことを検討
-			fmt.Printf("地域地点の検索に失敗しました: %s (該当する地点が見つかりませんでした)\n", originalErr.Error())
+			fmt.Fprintf(cmd.ErrOrStderr(), i18n.T("地域地点の検索に失敗しました: %s (該当する地点が見つかりませんでした)\n"), originalErr.Error())
 			return nil
 		}
 		return err // その他のエラーはそのまま返す
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors" // bytes は削除
 	"testing"
 
@@ -42,10 +43,10 @@ func TestRunWeatherPointLogic_Success(t *testing.T) {
 		},
 	}
 
-	mockClient.On("GetWeatherPoint", keyword).Return(expectedResponse, nil)
+	mockClient.On("GetWeatherPointContext", mock.Anything, keyword).Return(expectedResponse, nil)
 	mockPresenter.On("PresentWeatherPoint", expectedResponse, kata, keyword).Return(nil)
 
-	err := runWeatherPointLogic(mockClient, mockPresenter, keyword, kata)
+	err := runWeatherPointLogic(context.Background(), mockClient, mockPresenter, keyword, kata)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
@@ -66,10 +67,10 @@ func TestRunWeatherPointLogic_Success_Kata(t *testing.T) {
 		},
 	}
 
-	mockClient.On("GetWeatherPoint", keyword).Return(expectedResponse, nil)
+	mockClient.On("GetWeatherPointContext", mock.Anything, keyword).Return(expectedResponse, nil)
 	mockPresenter.On("PresentWeatherPoint", expectedResponse, kata, keyword).Return(nil)
 
-	err := runWeatherPointLogic(mockClient, mockPresenter, keyword, kata)
+	err := runWeatherPointLogic(context.Background(), mockClient, mockPresenter, keyword, kata)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
@@ -84,9 +85,9 @@ func TestRunWeatherPointLogic_ClientError(t *testing.T) {
 	kata := false
 	clientError := errors.New("API error from client")
 
-	mockClient.On("GetWeatherPoint", keyword).Return(models.GetWeatherPointResponse{}, clientError)
+	mockClient.On("GetWeatherPointContext", mock.Anything, keyword).Return(models.GetWeatherPointResponse{}, clientError)
 
-	err := runWeatherPointLogic(mockClient, mockPresenter, keyword, kata)
+	err := runWeatherPointLogic(context.Background(), mockClient, mockPresenter, keyword, kata)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "地域地点の検索に失敗しました: API error from client")
 
@@ -107,10 +108,10 @@ func TestRunWeatherPointLogic_PresenterError(t *testing.T) {
 	}
 	presenterError := errors.New("presenter failed to present")
 
-	mockClient.On("GetWeatherPoint", keyword).Return(expectedResponse, nil)
+	mockClient.On("GetWeatherPointContext", mock.Anything, keyword).Return(expectedResponse, nil)
 	mockPresenter.On("PresentWeatherPoint", expectedResponse, kata, keyword).Return(presenterError)
 
-	err := runWeatherPointLogic(mockClient, mockPresenter, keyword, kata)
+	err := runWeatherPointLogic(context.Background(), mockClient, mockPresenter, keyword, kata)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "結果の表示に失敗しました: presenter failed to present")
 
@@ -131,10 +132,10 @@ func TestRunWeatherPointLogic_NoResults(t *testing.T) {
 		},
 	}
 
-	mockClient.On("GetWeatherPoint", keyword).Return(emptyResponse, nil)
+	mockClient.On("GetWeatherPointContext", mock.Anything, keyword).Return(emptyResponse, nil)
 	mockPresenter.On("PresentWeatherPoint", emptyResponse, kata, keyword).Return(nil) // プレゼンターは結果なしを適切に処理すると期待
 
-	err := runWeatherPointLogic(mockClient, mockPresenter, keyword, kata)
+	err := runWeatherPointLogic(context.Background(), mockClient, mockPresenter, keyword, kata)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
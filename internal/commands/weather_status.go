@@ -1,10 +1,22 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
+	"time"
 	// "errors" // errors パッケージは現在直接使用されていないためコメントアウト
 	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/chart"
+	"github.com/eraiza0816/zu2l/internal/config"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models" // models をインポート
 	"github.com/eraiza0816/zu2l/internal/presenter"
 
@@ -14,28 +26,119 @@ import (
 // ダミーの変数宣言で models パッケージが使用されていることを示す (weather_point.go と同様)
 var _ models.GetWeatherStatusResponse
 
-// runWeatherStatusLogic は指定された cityCode と単一の dayOffset/dayName に対する
+// runWeatherStatusLogic は指定された cityCode と日付オフセットのリストに対する
 // 天気予報の取得と表示のコアロジックを担当します。
-func runWeatherStatusLogic(client ClientInterface, pres PresenterInterface, cityCode string, dayOffset int, dayName string) error {
-	// この関数内でAPI呼び出しを行う (テストの想定に合わせる)
-	res, err := client.GetWeatherStatus(cityCode)
+// 1回のレスポンスに昨日から明後日までのデータが含まれるため、API呼び出しは日数に関わらず1回のみです。
+func runWeatherStatusLogic(ctx context.Context, client ClientInterface, pres PresenterInterface, cityCode string, dayOffsets []int) error {
+	res, err := client.GetWeatherStatusContext(ctx, cityCode)
 	if err != nil {
-		return fmt.Errorf("気象状況の取得に失敗しました (%s): %w", cityCode, err)
+		return i18n.Errorf("気象状況の取得に失敗しました (%s): %w", cityCode, err)
 	}
 
-	err = pres.PresentWeatherStatus(res, dayOffset, dayName)
+	err = pres.PresentWeatherStatus(res, dayOffsets)
 	if err != nil {
-		return fmt.Errorf("%s の結果表示に失敗しました: %w", dayName, err)
+		return i18n.Errorf("結果の表示に失敗しました: %w", err)
 	}
 	return nil
 }
 
+// GraphPresenterInterface は気圧グラフを表示できるプレゼンターが満たすべきインターフェースです。
+// 現在は TablePresenter のみが実装しています。
+type GraphPresenterInterface interface {
+	PresentWeatherGraph(data models.GetWeatherStatusResponse, dayOffsets []int, temperature bool) error
+}
+
+// runWeatherGraphLogic は指定された cityCode の気象状況を取得し、dayOffsets の日の気圧グラフを表示します。
+// temperature が true の場合は気温も重ねて表示します。
+func runWeatherGraphLogic(ctx context.Context, client ClientInterface, pres GraphPresenterInterface, cityCode string, dayOffsets []int, temperature bool) error {
+	res, err := client.GetWeatherStatusContext(ctx, cityCode)
+	if err != nil {
+		return i18n.Errorf("気象状況の取得に失敗しました (%s): %w", cityCode, err)
+	}
+
+	if err := pres.PresentWeatherGraph(res, dayOffsets, temperature); err != nil {
+		return i18n.Errorf("グラフの表示に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// runWeatherExportLogic は指定された cityCode の気象状況を取得し、dayOffsets の日の気圧グラフを path に画像として保存します。
+// 画像形式は path の拡張子 (.svg または .png) で決まり、now の時刻に現在時刻の線を引きます。
+func runWeatherExportLogic(ctx context.Context, client ClientInterface, cityCode string, dayOffsets []int, path string, now time.Time) error {
+	format, err := chart.ExportFormat(path)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetWeatherStatusContext(ctx, cityCode)
+	if err != nil {
+		return i18n.Errorf("気象状況の取得に失敗しました (%s): %w", cityCode, err)
+	}
+	c, err := chart.NewPressureChart(res, dayOffsets)
+	if err != nil {
+		return err
+	}
+	c.Now = now
+
+	// 書き込みに失敗した場合に途中までの画像が残らないよう、同じディレクトリの一時ファイルに書いてからリネームする
+	tmp, err := createTempFile(filepath.Dir(path))
+	if err != nil {
+		return i18n.Errorf("出力ファイルの作成に失敗しました: %w", err)
+	}
+	if err := c.Write(tmp, format); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	// 既存のファイルを上書きする場合は、リネームで置き換わっても元のパーミッションを保つ
+	if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+		if err := tmp.Chmod(info.Mode().Perm()); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return i18n.Errorf("出力ファイルの書き込みに失敗しました: %w", err)
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return i18n.Errorf("出力ファイルの書き込みに失敗しました: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return i18n.Errorf("出力ファイルの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// createTempFile は dir に os.Create と同じパーミッション (0666 から umask を除いたもの) の一時ファイルを作成します。
+// os.CreateTemp は所有者のみ読み書きできるファイルを作成するため使用しません。
+func createTempFile(dir string) (*os.File, error) {
+	for i := 0; ; i++ {
+		name := filepath.Join(dir, ".tmp-"+strconv.FormatUint(uint64(rand.Uint32()), 10))
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o666)
+		if errors.Is(err, fs.ErrExist) && i < 100 {
+			continue
+		}
+		return f, err
+	}
+}
+
 // RunWeatherStatus は 'weather_status' コマンドの実行ロジック（アプリケーションサービス）です。
-func RunWeatherStatus(apiClient *api.Client, actualPresenter presenter.Presenter, cmd *cobra.Command, args []string) error {
-	if len(args) == 0 {
-		return fmt.Errorf("都市コードを指定してください")
+// 引数が @name 形式の場合は保存済み地点の地点コードを、地名の場合は地点検索の結果を使用します。
+// --graph が指定された場合は表の代わりに気圧グラフを表示します (table 形式の出力のみ)。
+// --export が指定された場合は表の代わりに気圧グラフを SVG または PNG の画像として保存します。
+func RunWeatherStatus(apiClient *api.Client, actualPresenter presenter.Presenter, cfg *config.Config, cmd *cobra.Command, args []string) error {
+	cityArg, ok := targetArg(cfg, args, cfg.DefaultCity)
+	if !ok {
+		return i18n.Errorf("都市コードを指定してください")
+	}
+	cityCode, err := resolveCityArg(cfg, cityArg)
+	if err != nil {
+		return err
+	}
+	// 地名 (例: 渋谷) が指定された場合は地点検索で地点コードに解決する
+	cityCode, err = resolveCityCode(cmd.Context(), apiClient, cityCode, chooserFor(cmd))
+	if err != nil {
+		return err
 	}
-	cityCode := args[0]
 	// TODO: Add validation for cityCode format (e.g., 3 digits from ddd_doc, or 6 digits for zutool API?)
 
 	nFlag, _ := cmd.Flags().GetIntSlice("n")
@@ -48,56 +151,39 @@ func RunWeatherStatus(apiClient *api.Client, actualPresenter presenter.Presenter
 		// あるいはデフォルトで今日 [0] を設定する。
 		// 今回は、指定がなければ何もしない（元のコードに近い挙動）か、エラーとする。
 		// わかりやすさのため、指定がない場合はエラーとする。
-		return fmt.Errorf("-n オプションで表示する日付オフセットを指定してください (例: -n 0 で今日)")
+		return i18n.Errorf("-n オプションで表示する日付オフセットを指定してください (例: -n 0 で今日)")
 	}
 
 	for _, n := range nFlag {
 		if n < -1 || n > 2 {
-			return fmt.Errorf("無効な日付オフセットです: %d (-1 から 2 の間で指定してください)", n)
+			return i18n.Errorf("無効な日付オフセットです: %d (-1 から 2 の間で指定してください)", n)
 		}
 	}
 	sort.Ints(nFlag) // ユーザーが順不同で指定しても、昇順で処理する
+	nFlag = slices.Compact(nFlag)
 
-	var pWrapper PresenterInterface
-	pWrapper, ok := actualPresenter.(PresenterInterface)
-	if !ok {
-		return fmt.Errorf("内部エラー: プレゼンターが期待されるインターフェースを満たしていません")
+	if path, _ := cmd.Flags().GetString("export"); path != "" {
+		if err := runWeatherExportLogic(cmd.Context(), apiClient, cityCode, nFlag, path, time.Now().In(models.JST)); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), i18n.T("気圧グラフを %s に保存しました\n"), path)
+		return nil
 	}
 
-	// API呼び出しは各 runWeatherStatusLogic 内で行われるため、ここではレスポンスをキャッシュしない。
-	// (テストの runWeatherStatusLogic の想定に合わせるため)
-	// もしAPI呼び出しを1回にしたい場合は、runWeatherStatusLogic の責務を変える必要がある。
-
-	for _, n := range nFlag {
-		var dayName string
-		// dayName の決定ロジックは presenter 側にある方が適切かもしれないが、
-		// PresenterInterface のシグネチャに合わせるため、ここで決定する。
-		// presenter.Presenter の PresentWeatherStatus が dayName を引数に取るため。
-		switch n {
-		case -1:
-			dayName = "yesterday" // 実際の表示名に合わせて調整 (例: "昨日")
-		case 0:
-			dayName = "today"     // (例: "今日")
-		case 1:
-			dayName = "tomorrow"  // (例: "明日")
-		case 2:
-			dayName = "dayaftertomorrow" // (例: "明後日")
-		default:
-			// バリデーションにより、このケースには到達しないはず
-			return fmt.Errorf("内部エラー: 無効な日付オフセット %d", n)
+	if graph, _ := cmd.Flags().GetBool("graph"); graph {
+		graphPresenter, ok := actualPresenter.(GraphPresenterInterface)
+		if !ok {
+			return i18n.Errorf("--graph は table 形式の出力でのみ使用できます")
 		}
+		temperature, _ := cmd.Flags().GetBool("temp")
+		return runWeatherGraphLogic(cmd.Context(), apiClient, graphPresenter, cityCode, nFlag, temperature)
+	}
 
-		// runWeatherStatusLogic を呼び出す
-		err := runWeatherStatusLogic(apiClient, pWrapper, cityCode, n, dayName)
-		if err != nil {
-			// TODO: 特定の日のエラーが発生しても、他の日の処理を続けるか、ここで全体をエラーとするか。
-			// 現在は最初のエラーで全体が終了する。
-			// 個々のエラーを収集して最後にまとめて報告するなども考えられる。
-			// ユーザー体験としては、エラーがあっても処理を続けられる方が良い場合もある。
-			// ここではシンプルに最初のエラーでリターンする。
-			return err
-		}
+	var pWrapper PresenterInterface
+	pWrapper, ok = actualPresenter.(PresenterInterface)
+	if !ok {
+		return i18n.Errorf("内部エラー: プレゼンターが期待されるインターフェースを満たしていません")
 	}
 
-	return nil
+	return runWeatherStatusLogic(cmd.Context(), apiClient, pWrapper, cityCode, nFlag)
 }
@@ -1,44 +1,43 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt" // Import fmt for Sprintf
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
-	// "time" // time is not used
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/eraiza0816/zu2l/internal/models"
 )
 
-// runWeatherStatusLogic は、weather_status.go にあるべきコアロジック関数（仮定）
-// このシグネチャは weather_status.go の実装に合わせる必要があります。
-// ddd_doc.md によると、Presenter は dayOffset と dayName も引数に取ります。
-// コマンドライン引数から dayOffset (today, tomorrow など) を解釈し、
-// それを数値の offset と表示用の dayName に変換するロジックがどこかにあるはずです。
-// ここでは、runWeatherStatusLogic が cityCode と、解決済みの dayOffset, dayName を受け取ると仮定します。
-// runWeatherStatusLogic は weather_status.go で定義されているため、ここでは削除します。
+// runWeatherStatusLogic は weather_status.go で定義されており、cityCode と表示する日付オフセットのリストを受け取ります。
+// API 呼び出しは日数に関わらず1回のみで、Presenter にはレスポンスとオフセットのリストがまとめて渡されます。
 
 func TestRunWeatherStatusLogic_Success_Today(t *testing.T) {
 	mockClient := new(MockClient)
 	mockPresenter := new(MockPresenter)
 
 	cityCode := "130010" // Tokyo
-	dayOffset := 0       // Today
-	dayName := "today"   // Changed to match weather_status.go
+	dayOffsets := []int{0} // Today
 	expectedResponse := models.GetWeatherStatusResponse{
 		PlaceName: "東京",
 		PlaceID:   "131", // Example PlaceID, ddd_doc says 3 digits
 		Today: []models.WeatherStatusByTime{
-			{Time: "0", Weather: models.Sunny, Temp: models.NewString("15.0"), Pressure: "1010", PressureLevel: models.Normal},
+			{Hour: 0, Weather: models.Sunny, Temp: models.NewFloat64(15.0), Pressure: 1010, PressureLevel: models.Normal},
 		},
 	}
 
-	mockClient.On("GetWeatherStatus", cityCode).Return(expectedResponse, nil)
-	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffset, dayName).Return(nil)
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffsets).Return(nil)
 
-	err := runWeatherStatusLogic(mockClient, mockPresenter, cityCode, dayOffset, dayName)
+	err := runWeatherStatusLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
@@ -50,43 +49,70 @@ func TestRunWeatherStatusLogic_Success_Tomorrow(t *testing.T) {
 	mockPresenter := new(MockPresenter)
 
 	cityCode := "130010"
-	dayOffset := 1 // Tomorrow
-	dayName := "tomorrow" // Changed to match weather_status.go
+	dayOffsets := []int{1} // Tomorrow
 	expectedResponse := models.GetWeatherStatusResponse{
 		PlaceName: "東京",
 		Tomorrow: []models.WeatherStatusByTime{
-			{Time: "0", Weather: models.Cloudy, Temp: models.NewString("18.0"), Pressure: "1008", PressureLevel: models.SlightAlert},
+			{Hour: 0, Weather: models.Cloudy, Temp: models.NewFloat64(18.0), Pressure: 1008, PressureLevel: models.SlightAlert},
 		},
 	}
 
-	mockClient.On("GetWeatherStatus", cityCode).Return(expectedResponse, nil)
-	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffset, dayName).Return(nil)
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffsets).Return(nil)
 
-	err := runWeatherStatusLogic(mockClient, mockPresenter, cityCode, dayOffset, dayName)
+	err := runWeatherStatusLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets)
 	assert.NoError(t, err)
 
 	mockClient.AssertExpectations(t)
 	mockPresenter.AssertExpectations(t)
 }
 
+func TestRunWeatherStatusLogic_MultipleDays_SingleAPICall(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := new(MockPresenter)
+
+	cityCode := "13113"
+	dayOffsets := []int{-1, 0, 1, 2} // 昨日から明後日まで全て
+	expectedResponse := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		Yesterday: []models.WeatherStatusByTime{{Hour: 0, Weather: models.Rain, Pressure: 1005.0, PressureLevel: models.Caution}},
+		Today:     []models.WeatherStatusByTime{{Hour: 0, Weather: models.Sunny, Pressure: 1010.0, PressureLevel: models.Normal}},
+		Tomorrow:  []models.WeatherStatusByTime{{Hour: 0, Weather: models.Cloudy, Pressure: 1008.0, PressureLevel: models.SlightAlert}},
+		DayAfterTomorrow: []models.WeatherStatusByTime{
+			{Hour: 0, Weather: models.Snow, Pressure: 1001.0, PressureLevel: models.Alert},
+		},
+	}
+
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffsets).Return(nil)
+
+	err := runWeatherStatusLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets)
+	assert.NoError(t, err)
+
+	// 複数日を指定しても API 呼び出しと表示はそれぞれ1回のみ
+	mockClient.AssertNumberOfCalls(t, "GetWeatherStatusContext", 1)
+	mockPresenter.AssertNumberOfCalls(t, "PresentWeatherStatus", 1)
+	mockClient.AssertExpectations(t)
+	mockPresenter.AssertExpectations(t)
+}
+
 func TestRunWeatherStatusLogic_ClientError(t *testing.T) {
 	mockClient := new(MockClient)
 	mockPresenter := new(MockPresenter)
 
 	cityCode := "999999" // Invalid city code
-	dayOffset := 0
-	dayName := "today"   // Changed to match weather_status.go
+	dayOffsets := []int{0}
 	clientError := errors.New("API client failed")
 
-	mockClient.On("GetWeatherStatus", cityCode).Return(models.GetWeatherStatusResponse{}, clientError)
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(models.GetWeatherStatusResponse{}, clientError)
 
-	err := runWeatherStatusLogic(mockClient, mockPresenter, cityCode, dayOffset, dayName)
+	err := runWeatherStatusLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets)
 	assert.Error(t, err)
 	expectedErrorMessage := fmt.Sprintf("気象状況の取得に失敗しました (%s): %s", cityCode, clientError.Error())
 	assert.EqualError(t, err, expectedErrorMessage)
 
 	mockClient.AssertExpectations(t)
-	mockPresenter.AssertNotCalled(t, "PresentWeatherStatus", mock.Anything, mock.Anything, mock.Anything)
+	mockPresenter.AssertNotCalled(t, "PresentWeatherStatus", mock.Anything, mock.Anything)
 }
 
 func TestRunWeatherStatusLogic_PresenterError(t *testing.T) {
@@ -94,17 +120,16 @@ func TestRunWeatherStatusLogic_PresenterError(t *testing.T) {
 	mockPresenter := new(MockPresenter)
 
 	cityCode := "130010"
-	dayOffset := 0
-	dayName := "today"   // Changed to match weather_status.go
+	dayOffsets := []int{0, 1}
 	expectedResponse := models.GetWeatherStatusResponse{PlaceName: "東京"}
 	presenterError := errors.New("presenter display failed")
 
-	mockClient.On("GetWeatherStatus", cityCode).Return(expectedResponse, nil)
-	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffset, dayName).Return(presenterError)
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherStatus", expectedResponse, dayOffsets).Return(presenterError)
 
-	err := runWeatherStatusLogic(mockClient, mockPresenter, cityCode, dayOffset, dayName)
+	err := runWeatherStatusLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets)
 	assert.Error(t, err)
-	expectedErrorMessage := fmt.Sprintf("%s の結果表示に失敗しました: %s", dayName, presenterError.Error())
+	expectedErrorMessage := fmt.Sprintf("結果の表示に失敗しました: %s", presenterError.Error())
 	assert.EqualError(t, err, expectedErrorMessage)
 
 
@@ -112,8 +137,122 @@ func TestRunWeatherStatusLogic_PresenterError(t *testing.T) {
 	mockPresenter.AssertExpectations(t)
 }
 
-// Helper function to create a pointer to a string, useful for nullable fields like Temp.
-// This should ideally be in a shared utility or models package if used frequently.
-// For now, defining it here for models.NewString usage.
-// func NewString(s string) *string { return &s }
-// models.NewString が internal/models にあると仮定。なければ上記を有効化。
+// Temp のような nullable な数値フィールドには models.NewFloat64 を使用する。
+
+// PresentWeatherGraph is a mock method (added for weather_status --graph)
+func (m *MockPresenter) PresentWeatherGraph(data models.GetWeatherStatusResponse, dayOffsets []int, temperature bool) error {
+	args := m.Called(data, dayOffsets, temperature)
+	return args.Error(0)
+}
+
+func TestRunWeatherGraphLogic(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := new(MockPresenter)
+
+	cityCode := "130010"
+	dayOffsets := []int{0, 1}
+	expectedResponse := models.GetWeatherStatusResponse{PlaceName: "東京"}
+
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherGraph", expectedResponse, dayOffsets, true).Return(nil)
+
+	err := runWeatherGraphLogic(context.Background(), mockClient, mockPresenter, cityCode, dayOffsets, true)
+	assert.NoError(t, err)
+
+	mockClient.AssertExpectations(t)
+	mockPresenter.AssertExpectations(t)
+	mockPresenter.AssertNotCalled(t, "PresentWeatherStatus", mock.Anything, mock.Anything)
+}
+
+func TestRunWeatherGraphLogic_PresenterError(t *testing.T) {
+	mockClient := new(MockClient)
+	mockPresenter := new(MockPresenter)
+
+	cityCode := "130010"
+	expectedResponse := models.GetWeatherStatusResponse{PlaceName: "東京"}
+	presenterError := errors.New("graph failed")
+
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(expectedResponse, nil)
+	mockPresenter.On("PresentWeatherGraph", expectedResponse, []int{0}, false).Return(presenterError)
+
+	err := runWeatherGraphLogic(context.Background(), mockClient, mockPresenter, cityCode, []int{0}, false)
+	assert.EqualError(t, err, "グラフの表示に失敗しました: graph failed")
+}
+
+// Ensure MockPresenter implements commands.GraphPresenterInterface
+var _ GraphPresenterInterface = (*MockPresenter)(nil)
+
+func TestRunWeatherExportLogic(t *testing.T) {
+	mockClient := new(MockClient)
+	cityCode := "13113"
+	at := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	response := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		Today: []models.WeatherStatusByTime{
+			{Hour: 0, At: at, Weather: models.Sunny, Pressure: 1012, PressureLevel: models.Normal},
+			{Hour: 1, At: at.Add(time.Hour), Weather: models.Rain, Pressure: 1010, PressureLevel: models.Alert},
+		},
+	}
+	mockClient.On("GetWeatherStatusContext", mock.Anything, cityCode).Return(response, nil)
+
+	path := filepath.Join(t.TempDir(), "chart.svg")
+	err := runWeatherExportLogic(context.Background(), mockClient, cityCode, []int{0}, path, at.Add(90*time.Minute))
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "<svg "), "SVG が保存されるはずです")
+	assert.Contains(t, string(data), `class="now"`, "現在時刻の線が描画されるはずです")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, createdFileMode(t), info.Mode().Perm(), "os.Create と同じく umask を適用したパーミッションになるはずです")
+
+	// 既存のファイルを上書きする場合はパーミッションを保つ
+	require.NoError(t, os.Chmod(path, 0o600))
+	err = runWeatherExportLogic(context.Background(), mockClient, cityCode, []int{0}, path, at)
+	require.NoError(t, err)
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "既存のファイルのパーミッションを保つはずです")
+	mockClient.AssertExpectations(t)
+}
+
+// createdFileMode は os.Create で作成したファイルのパーミッション (0666 から umask を除いたもの) を返します。
+func createdFileMode(t *testing.T) os.FileMode {
+	t.Helper()
+	f, err := os.Create(filepath.Join(t.TempDir(), "probe"))
+	require.NoError(t, err)
+	defer f.Close()
+	info, err := f.Stat()
+	require.NoError(t, err)
+	return info.Mode().Perm()
+}
+
+func TestRunWeatherExportLogic_Errors(t *testing.T) {
+	mockClient := new(MockClient)
+	dir := t.TempDir()
+
+	err := runWeatherExportLogic(context.Background(), mockClient, "13113", []int{0}, filepath.Join(dir, "chart.gif"), time.Now())
+	assert.ErrorContains(t, err, "画像形式を判別できません")
+	mockClient.AssertNotCalled(t, "GetWeatherStatusContext", mock.Anything, mock.Anything)
+
+	mockClient.On("GetWeatherStatusContext", mock.Anything, "13113").Return(models.GetWeatherStatusResponse{}, nil)
+	path := filepath.Join(dir, "chart.png")
+	err = runWeatherExportLogic(context.Background(), mockClient, "13113", []int{0}, path, time.Now())
+	assert.EqualError(t, err, "グラフにする気圧データがありません")
+	assert.NoFileExists(t, path, "データが無い場合はファイルを作成しないはずです")
+
+	// 保存に失敗した場合は一時ファイルが残らない
+	mockClient.On("GetWeatherStatusContext", mock.Anything, "01101").Return(models.GetWeatherStatusResponse{
+		Today: []models.WeatherStatusByTime{{Hour: 0, At: time.Now(), Weather: models.Sunny, Pressure: 1012, PressureLevel: models.Normal}},
+	}, nil)
+	blocked := filepath.Join(dir, "blocked.svg")
+	require.NoError(t, os.Mkdir(blocked, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(blocked, "keep"), nil, 0o644))
+	err = runWeatherExportLogic(context.Background(), mockClient, "01101", []int{0}, blocked, time.Now())
+	assert.ErrorContains(t, err, "出力ファイルの保存に失敗しました")
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.False(t, strings.HasPrefix(entry.Name(), ".tmp-"), "一時ファイル %s が残っています", entry.Name())
+	}
+}
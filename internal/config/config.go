@@ -0,0 +1,334 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/output"
+)
+
+// EnvPrefix は設定を上書きする環境変数の接頭辞です。
+// 例えばキー "retry.max_attempts" は ZUTOOL_RETRY_MAX_ATTEMPTS で上書きできます。
+const EnvPrefix = "ZUTOOL_"
+
+// PathEnv は設定ファイルのパスを指定する環境変数です。
+const PathEnv = EnvPrefix + "CONFIG"
+
+// Config は zutool の設定です。
+// 値の優先順位は コマンドラインフラグ > 環境変数 > 設定ファイル > デフォルト値 です。
+type Config struct {
+	BaseURL       string        `yaml:"base_url,omitempty"`
+	OtenkiBaseURL string        `yaml:"otenki_base_url,omitempty"`
+	Timeout       time.Duration `yaml:"timeout,omitempty"`
+	Format        string        `yaml:"format,omitempty"`       // デフォルトの出力形式 (output.Formats のいずれか)
+	Template      string        `yaml:"template,omitempty"`     // format が template の場合に使用する Go テンプレート (ファイルのパスまたはテンプレート文字列)
+	Color         string        `yaml:"color,omitempty"`        // format が table の場合に色を付けるか (output.ColorModes のいずれか、空の場合は auto)
+	Theme         string        `yaml:"theme,omitempty"`        // format が table の場合の色のテーマ (output.ThemeNames のいずれか、空の場合は default)
+	Lang          string        `yaml:"lang,omitempty"`         // 表示する言語 (i18n.Langs のいずれか、空の場合は環境変数 LANG などから判定)
+	DefaultArea   string        `yaml:"default_area,omitempty"` // pain_status で引数を省略した場合の地域
+	DefaultCity   string        `yaml:"default_city,omitempty"` // weather_status と otenki_asp で引数を省略した場合の都市
+	Retry         RetryConfig   `yaml:"retry,omitempty"`
+	Cache         CacheConfig   `yaml:"cache,omitempty"`
+
+	DefaultLocation string              `yaml:"default_location,omitempty"` // 引数もデフォルトの地域・都市も無い場合に使用する地点名
+	Locations       map[string]Location `yaml:"locations,omitempty"`        // 名前付きの地点 (@name で参照)
+}
+
+// RetryConfig はリトライの設定です。api.RetryPolicy に変換して使用します。
+type RetryConfig struct {
+	MaxAttempts    int           `yaml:"max_attempts,omitempty"`
+	InitialBackoff time.Duration `yaml:"initial_backoff,omitempty"`
+	MaxBackoff     time.Duration `yaml:"max_backoff,omitempty"`
+}
+
+// CacheConfig はレスポンスキャッシュの設定です。
+type CacheConfig struct {
+	Enabled *bool                    `yaml:"enabled,omitempty"` // nil の場合は有効
+	Dir     string                   `yaml:"dir,omitempty"`     // 空の場合は XDG キャッシュディレクトリ
+	TTL     map[string]time.Duration `yaml:"ttl,omitempty"`     // エンドポイントごとの有効期限の上書き
+}
+
+// IsEnabled はキャッシュが有効かどうかを返します。
+func (c CacheConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// Default はデフォルト値の設定を返します。
+func Default() *Config {
+	policy := api.DefaultRetryPolicy()
+	return &Config{
+		BaseURL:       api.DefaultBaseURL,
+		OtenkiBaseURL: api.DefaultOtenkiASPBaseURL,
+		Timeout:       api.DefaultTimeout,
+		Format:        "table",
+		Retry: RetryConfig{
+			MaxAttempts:    policy.MaxAttempts,
+			InitialBackoff: policy.InitialBackoff,
+			MaxBackoff:     policy.MaxBackoff,
+		},
+	}
+}
+
+// RetryPolicy は設定に基づいた api.RetryPolicy を返します。
+func (c *Config) RetryPolicy() api.RetryPolicy {
+	policy := api.DefaultRetryPolicy()
+	policy.MaxAttempts = c.Retry.MaxAttempts
+	policy.InitialBackoff = c.Retry.InitialBackoff
+	policy.MaxBackoff = c.Retry.MaxBackoff
+	return policy
+}
+
+// DefaultPath は設定ファイルのパスを返します。
+// 環境変数 ZUTOOL_CONFIG が設定されていればその値を、無ければ XDG_CONFIG_HOME/zutool/config.yaml などを返します。
+func DefaultPath() (string, error) {
+	if path, ok := os.LookupEnv(PathEnv); ok && path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", i18n.Errorf("設定ディレクトリを特定できませんでした: %w", err)
+	}
+	return filepath.Join(dir, "zutool", "config.yaml"), nil
+}
+
+// Load はデフォルト値に設定ファイル (存在する場合) と環境変数を順に適用した設定を返します。
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if err := decodeFile(path, cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadFile は設定ファイルに明示的に書かれた値のみを読み込みます (デフォルト値や環境変数は適用しません)。
+// ファイルが存在しない場合は空の設定を返します。'config set' での書き換えに使用します。
+func LoadFile(path string) (*Config, error) {
+	cfg := &Config{}
+	if err := decodeFile(path, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Save は設定を YAML 形式で path に書き込みます。親ディレクトリが無ければ作成します。
+func (c *Config) Save(path string) error {
+	data, err := c.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return i18n.Errorf("設定ディレクトリの作成に失敗しました: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return i18n.Errorf("設定ファイルの書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// Marshal は設定を YAML 形式に変換します。
+func (c *Config) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return nil, i18n.Errorf("設定のマーシャリングに失敗しました: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, i18n.Errorf("設定のマーシャリングに失敗しました: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Keys は 'config set' や環境変数で指定できるキーの一覧を返します。
+func Keys() []string {
+	keys := []string{
+		"base_url",
+		"otenki_base_url",
+		"timeout",
+		"format",
+		"template",
+		"color",
+		"theme",
+		"lang",
+		"default_area",
+		"default_city",
+		"default_location",
+		"retry.max_attempts",
+		"retry.initial_backoff",
+		"retry.max_backoff",
+		"cache.enabled",
+		"cache.dir",
+	}
+	for _, endpoint := range cacheEndpoints() {
+		keys = append(keys, "cache.ttl."+endpoint)
+	}
+	return keys
+}
+
+// EnvName はキーに対応する環境変数名を返します (例: "retry.max_attempts" → "ZUTOOL_RETRY_MAX_ATTEMPTS")。
+func EnvName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// ApplyEnv は lookup (通常は os.LookupEnv) から ZUTOOL_* 環境変数を読み取り、設定を上書きします。
+func (c *Config) ApplyEnv(lookup func(string) (string, bool)) error {
+	for _, key := range Keys() {
+		name := EnvName(key)
+		value, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		if err := c.Set(key, value); err != nil {
+			return i18n.Errorf("環境変数 %s の値が不正です: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Set は key に対応する設定値を文字列 value から変換して設定します。
+func (c *Config) Set(key, value string) error {
+	switch key {
+	case "base_url":
+		c.BaseURL = value
+	case "otenki_base_url":
+		c.OtenkiBaseURL = value
+	case "timeout":
+		return setDuration(&c.Timeout, value)
+	case "format":
+		if !contains(output.Formats, value) {
+			return i18n.Errorf("無効な出力形式です: %s (%s のいずれかを指定してください)", value, strings.Join(output.Formats, ", "))
+		}
+		c.Format = value
+	case "template":
+		c.Template = value
+	case "color":
+		if !contains(output.ColorModes, value) {
+			return i18n.Errorf("無効な色の指定です: %s (%s のいずれかを指定してください)", value, strings.Join(output.ColorModes, ", "))
+		}
+		c.Color = value
+	case "theme":
+		if !contains(output.ThemeNames, value) {
+			return i18n.Errorf("無効なテーマです: %s (%s のいずれかを指定してください)", value, strings.Join(output.ThemeNames, ", "))
+		}
+		c.Theme = value
+	case "lang":
+		if !contains(i18n.Langs, value) {
+			return i18n.Errorf("無効な言語です: %s (%s のいずれかを指定してください)", value, strings.Join(i18n.Langs, ", "))
+		}
+		c.Lang = value
+	case "default_area":
+		c.DefaultArea = value
+	case "default_city":
+		c.DefaultCity = value
+	case "default_location":
+		if value != "" {
+			if _, err := c.Location(value); err != nil {
+				return err
+			}
+		}
+		c.DefaultLocation = value
+	case "retry.max_attempts":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return i18n.Errorf("1 以上の整数を指定してください: %s", value)
+		}
+		c.Retry.MaxAttempts = n
+	case "retry.initial_backoff":
+		return setDuration(&c.Retry.InitialBackoff, value)
+	case "retry.max_backoff":
+		return setDuration(&c.Retry.MaxBackoff, value)
+	case "cache.enabled":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return i18n.Errorf("true または false を指定してください: %s", value)
+		}
+		c.Cache.Enabled = &b
+	case "cache.dir":
+		c.Cache.Dir = value
+	default:
+		endpoint, ok := strings.CutPrefix(key, "cache.ttl.")
+		if !ok || !contains(cacheEndpoints(), endpoint) {
+			return i18n.Errorf("不明な設定キーです: %s (有効なキー: %s)", key, strings.Join(Keys(), ", "))
+		}
+		var ttl time.Duration
+		if err := setDuration(&ttl, value); err != nil {
+			return err
+		}
+		if c.Cache.TTL == nil {
+			c.Cache.TTL = make(map[string]time.Duration)
+		}
+		c.Cache.TTL[endpoint] = ttl
+	}
+	return nil
+}
+
+// decodeFile は path の YAML を cfg に上書きでデコードします。ファイルが無い場合は何もしません。
+func decodeFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return i18n.Errorf("設定ファイルの読み込みに失敗しました: %w", err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return i18n.Errorf("設定ファイル %s の解析に失敗しました: %w", path, err)
+	}
+	if cfg.Format != "" && !contains(output.Formats, cfg.Format) {
+		return i18n.Errorf("設定ファイル %s の format が無効です: %s", path, cfg.Format)
+	}
+	if cfg.Color != "" && !contains(output.ColorModes, cfg.Color) {
+		return i18n.Errorf("設定ファイル %s の color が無効です: %s", path, cfg.Color)
+	}
+	if cfg.Theme != "" && !contains(output.ThemeNames, cfg.Theme) {
+		return i18n.Errorf("設定ファイル %s の theme が無効です: %s", path, cfg.Theme)
+	}
+	if cfg.Lang != "" && !contains(i18n.Langs, cfg.Lang) {
+		return i18n.Errorf("設定ファイル %s の lang が無効です: %s", path, cfg.Lang)
+	}
+	return nil
+}
+
+// cacheEndpoints は TTL を設定できるエンドポイント名を昇順で返します。
+func cacheEndpoints() []string {
+	endpoints := []string{
+		api.EndpointPainStatus,
+		api.EndpointWeatherPoint,
+		api.EndpointWeatherStatus,
+		api.EndpointOtenkiASP,
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+func setDuration(dst *time.Duration, value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return i18n.Errorf("0 以上の期間 (例: 10s, 5m) を指定してください: %s", value)
+	}
+	*dst = d
+	return nil
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
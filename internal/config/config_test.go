@@ -0,0 +1,146 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/api"
+	"github.com/eraiza0816/zu2l/internal/output"
+)
+
+// envMap は ApplyEnv に渡す lookup 関数をマップから作成します。
+func envMap(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func TestLoadMissingFileReturnsDefault(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+	assert.True(t, cfg.Cache.IsEnabled(), "デフォルトではキャッシュは有効のはずです")
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+base_url: http://localhost:8080/api
+timeout: 20s
+format: json
+default_city: "13113"
+retry:
+  max_attempts: 5
+cache:
+  enabled: false
+  ttl:
+    getpainstatus: 1m
+`), 0o644))
+	t.Setenv(EnvName("timeout"), "30s")
+	t.Setenv(EnvName("cache.ttl.getpainstatus"), "2m")
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "http://localhost:8080/api", cfg.BaseURL, "ファイルの値が使われるはずです")
+	assert.Equal(t, api.DefaultOtenkiASPBaseURL, cfg.OtenkiBaseURL, "未指定の値はデフォルトのはずです")
+	assert.Equal(t, 30*time.Second, cfg.Timeout, "環境変数がファイルより優先されるはずです")
+	assert.Equal(t, "json", cfg.Format)
+	assert.Equal(t, "13113", cfg.DefaultCity)
+	assert.Equal(t, 5, cfg.Retry.MaxAttempts)
+	assert.Equal(t, api.DefaultRetryPolicy().InitialBackoff, cfg.Retry.InitialBackoff)
+	assert.False(t, cfg.Cache.IsEnabled())
+	assert.Equal(t, 2*time.Minute, cfg.Cache.TTL[api.EndpointPainStatus])
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("format: xml\n"), 0o644))
+
+	_, err := Load(path)
+	assert.Error(t, err, "無効な format はエラーになるはずです")
+}
+
+func TestApplyEnvInvalidValue(t *testing.T) {
+	cfg := Default()
+	err := cfg.ApplyEnv(envMap(map[string]string{"ZUTOOL_RETRY_MAX_ATTEMPTS": "abc"}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ZUTOOL_RETRY_MAX_ATTEMPTS")
+}
+
+func TestSet(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, cfg.Set("otenki_base_url", "http://localhost/otenki"))
+	require.NoError(t, cfg.Set("retry.max_backoff", "10s"))
+	require.NoError(t, cfg.Set("cache.enabled", "false"))
+	require.NoError(t, cfg.Set("cache.ttl.otenki_asp", "1h"))
+	require.NoError(t, cfg.Set("color", "never"))
+	require.NoError(t, cfg.Set("theme", "mono"))
+	require.NoError(t, cfg.Set("lang", "en"))
+
+	assert.Equal(t, "http://localhost/otenki", cfg.OtenkiBaseURL)
+	assert.Equal(t, 10*time.Second, cfg.Retry.MaxBackoff)
+	assert.False(t, cfg.Cache.IsEnabled())
+	assert.Equal(t, time.Hour, cfg.Cache.TTL[api.EndpointOtenkiASP])
+	assert.Equal(t, "never", cfg.Color)
+	assert.Equal(t, "mono", cfg.Theme)
+	assert.Equal(t, "en", cfg.Lang)
+
+	assert.Error(t, cfg.Set("unknown", "x"), "不明なキーはエラーになるはずです")
+	assert.Error(t, cfg.Set("cache.ttl.unknown", "1h"), "不明なエンドポイントはエラーになるはずです")
+	assert.Error(t, cfg.Set("timeout", "ten seconds"), "不正な期間はエラーになるはずです")
+	assert.Error(t, cfg.Set("retry.max_attempts", "0"), "1 未満の試行回数はエラーになるはずです")
+	assert.Error(t, cfg.Set("color", "sometimes"), "無効な色の指定はエラーになるはずです")
+	assert.Error(t, cfg.Set("theme", "rainbow"), "無効なテーマはエラーになるはずです")
+	assert.Error(t, cfg.Set("lang", "fr"), "無効な言語はエラーになるはずです")
+}
+
+func TestSetAcceptsOutputValues(t *testing.T) {
+	cfg := &Config{}
+	for _, format := range output.Formats {
+		assert.NoError(t, cfg.Set("format", format), "出力形式 %s は設定できるはずです", format)
+	}
+	for _, mode := range output.ColorModes {
+		assert.NoError(t, cfg.Set("color", mode))
+	}
+	for _, theme := range output.ThemeNames {
+		assert.NoError(t, cfg.Set("theme", theme))
+	}
+}
+
+func TestSaveAndLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.yaml")
+
+	cfg, err := LoadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg, "ファイルが無い場合は空の設定のはずです")
+
+	require.NoError(t, cfg.Set("default_area", "13"))
+	require.NoError(t, cfg.Save(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "default_area: \"13\"\n", string(data), "明示的に設定した値のみ書き込まれるはずです")
+
+	loaded, err := LoadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "13", loaded.DefaultArea)
+}
+
+func TestDefaultPathFromEnv(t *testing.T) {
+	t.Setenv(PathEnv, "/tmp/zutool-test.yaml")
+	path, err := DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/zutool-test.yaml", path)
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "ZUTOOL_BASE_URL", EnvName("base_url"))
+	assert.Equal(t, "ZUTOOL_CACHE_TTL_GETPAINSTATUS", EnvName("cache.ttl.getpainstatus"))
+}
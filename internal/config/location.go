@@ -0,0 +1,105 @@
+package config
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// LocationPrefix は引数で保存済みの地点を参照する際の接頭辞です (例: @home)。
+const LocationPrefix = "@"
+
+// Location は名前を付けて保存した地点です。
+type Location struct {
+	City string `yaml:"city"`           // 地点コード (例: 13113)。weather_status, otenki_asp と pain_status の set_weather_point に使用
+	Area string `yaml:"area,omitempty"` // 痛み予報の地域コード。省略時は地点コードの先頭2桁 (都道府県コード)
+}
+
+// AreaCode は痛み予報に使用する地域コードを返します。
+func (l Location) AreaCode() string {
+	if l.Area != "" {
+		return l.Area
+	}
+	if len(l.City) >= 2 {
+		return l.City[:2]
+	}
+	return l.City
+}
+
+// Validate は地点の内容が正しいかを検証します。
+func (l Location) Validate() error {
+	if len(l.City) < 2 || !isDigits(l.City) {
+		return i18n.Errorf("地点コードは数字で指定してください (例: 13113): '%s'", l.City)
+	}
+	if l.Area != "" && !isDigits(l.Area) {
+		return i18n.Errorf("地域コードは数字で指定してください (例: 13): '%s'", l.Area)
+	}
+	return nil
+}
+
+// Location は name という名前で保存された地点を返します。
+func (c *Config) Location(name string) (Location, error) {
+	loc, ok := c.Locations[name]
+	if !ok {
+		return Location{}, i18n.Errorf("地点 '%s' は登録されていません (登録済み: %s)", name, strings.Join(c.LocationNames(), ", "))
+	}
+	return loc, nil
+}
+
+// LocationNames は保存済みの地点名を昇順で返します。
+func (c *Config) LocationNames() []string {
+	names := make([]string, 0, len(c.Locations))
+	for name := range c.Locations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AddLocation は地点を name という名前で保存します。同名の地点がある場合は上書きします。
+func (c *Config) AddLocation(name string, loc Location) error {
+	if name == "" || strings.HasPrefix(name, LocationPrefix) || strings.ContainsAny(name, " \t") {
+		return i18n.Errorf("無効な地点名です: '%s' (空白や先頭の %s は使用できません)", name, LocationPrefix)
+	}
+	if err := loc.Validate(); err != nil {
+		return err
+	}
+	if c.Locations == nil {
+		c.Locations = make(map[string]Location)
+	}
+	c.Locations[name] = loc
+	return nil
+}
+
+// RemoveLocation は name という名前の地点を削除します。デフォルトの地点だった場合はデフォルト設定も解除します。
+func (c *Config) RemoveLocation(name string) error {
+	if _, err := c.Location(name); err != nil {
+		return err
+	}
+	delete(c.Locations, name)
+	if c.DefaultLocation == name {
+		c.DefaultLocation = ""
+	}
+	return nil
+}
+
+// LookupLocation は arg が @name 形式であれば保存済みの地点を返します。
+// @name 形式でない場合は ok が false になります。
+func (c *Config) LookupLocation(arg string) (loc Location, ok bool, err error) {
+	name, ok := strings.CutPrefix(arg, LocationPrefix)
+	if !ok {
+		return Location{}, false, nil
+	}
+	loc, err = c.Location(name)
+	return loc, true, err
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationAreaCode(t *testing.T) {
+	assert.Equal(t, "13", Location{City: "13113"}.AreaCode(), "省略時は地点コードの先頭2桁のはずです")
+	assert.Equal(t, "130010", Location{City: "13113", Area: "130010"}.AreaCode())
+}
+
+func TestAddAndRemoveLocation(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, cfg.AddLocation("home", Location{City: "13113"}))
+	require.NoError(t, cfg.Set("default_location", "home"))
+
+	loc, ok, err := cfg.LookupLocation("@home")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "13113", loc.City)
+
+	_, ok, err = cfg.LookupLocation("13113")
+	require.NoError(t, err)
+	assert.False(t, ok, "@ で始まらない引数は地点として扱わないはずです")
+
+	_, ok, err = cfg.LookupLocation("@office")
+	assert.True(t, ok)
+	assert.Error(t, err, "未登録の地点はエラーになるはずです")
+
+	require.NoError(t, cfg.RemoveLocation("home"))
+	assert.Empty(t, cfg.DefaultLocation, "デフォルトの地点を削除した場合はデフォルト設定も解除されるはずです")
+	assert.Error(t, cfg.RemoveLocation("home"))
+}
+
+func TestAddLocationValidation(t *testing.T) {
+	cfg := &Config{}
+	assert.Error(t, cfg.AddLocation("", Location{City: "13113"}), "空の地点名はエラーになるはずです")
+	assert.Error(t, cfg.AddLocation("@home", Location{City: "13113"}), "@ で始まる地点名はエラーになるはずです")
+	assert.Error(t, cfg.AddLocation("home", Location{City: "渋谷"}), "数字でない地点コードはエラーになるはずです")
+	assert.Error(t, cfg.AddLocation("home", Location{City: "13113", Area: "tokyo"}), "数字でない地域コードはエラーになるはずです")
+	assert.Error(t, cfg.Set("default_location", "home"), "未登録の地点はデフォルトにできないはずです")
+}
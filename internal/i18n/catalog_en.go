@@ -0,0 +1,450 @@
+package i18n
+
+// en は英語のメッセージカタログです。キーは日本語の原文で、書式指定子 (%s や %w など) は原文と同じ順序で含める必要があります。
+var en = map[string]string{
+	// --- 気圧レベル・天気・風向など (models) ---
+	"通常":           "Normal",
+	"やや注意":         "Slight caution",
+	"注意":           "Caution",
+	"警戒":           "Alert",
+	"厳重警戒":         "Severe alert",
+	"不明な気圧レベル(%s)": "Unknown pressure level (%s)",
+	"心配なし":         "No concern",
+	"不明な頭痛レベル(%d)": "Unknown headache level (%d)",
+
+	"晴れ":           "Sunny",
+	"晴れ時々くもり":      "Sunny, occasionally cloudy",
+	"晴れ一時雨":        "Sunny, brief rain",
+	"晴れ時々雨":        "Sunny, occasional rain",
+	"晴れ一時雪":        "Sunny, brief snow",
+	"晴れ時々雪":        "Sunny, occasional snow",
+	"晴れ一時雨か雪":      "Sunny, brief rain or snow",
+	"晴れ時々雨か雪":      "Sunny, occasional rain or snow",
+	"晴れ一時雨か雷雨":     "Sunny, brief rain or thunderstorms",
+	"晴れのち時々くもり":    "Sunny, occasionally cloudy later",
+	"晴れのちくもり":      "Sunny, cloudy later",
+	"晴れのち一時雨":      "Sunny, brief rain later",
+	"晴れのち時々雨":      "Sunny, occasional rain later",
+	"晴れのち雨":        "Sunny, rain later",
+	"晴れのち一時雪":      "Sunny, brief snow later",
+	"晴れのち時々雪":      "Sunny, occasional snow later",
+	"晴れのち雪":        "Sunny, snow later",
+	"晴れのち雨か雪":      "Sunny, rain or snow later",
+	"晴れのち雨か雷雨":     "Sunny, rain or thunderstorms later",
+	"晴れ朝夕一時雨":      "Sunny, brief rain in the morning and evening",
+	"晴れ朝の内一時雨":     "Sunny, brief rain in the morning",
+	"晴れ夕方一時雨":      "Sunny, brief rain in the evening",
+	"晴れ山沿い雷雨":      "Sunny, thunderstorms in the mountains",
+	"晴れ山沿い雪":       "Sunny, snow in the mountains",
+	"晴れ午後は雷雨":      "Sunny, thunderstorms in the afternoon",
+	"晴れ昼頃から雨":      "Sunny, rain from around noon",
+	"晴れ夕方から雨":      "Sunny, rain from the evening",
+	"晴れ夜は雨":        "Sunny, rain at night",
+	"朝の内霧のち晴れ":     "Morning fog, sunny later",
+	"晴れ明け方霧":       "Sunny, fog at dawn",
+	"晴れ朝夕くもり":      "Sunny, cloudy in the morning and evening",
+	"晴れ時々雨で雷を伴う":   "Sunny, occasional rain with thunder",
+	"晴れ一時雪か雨":      "Sunny, brief snow or rain",
+	"晴れ時々雪か雨":      "Sunny, occasional snow or rain",
+	"晴れのち雪か雨":      "Sunny, snow or rain later",
+	"くもり":          "Cloudy",
+	"くもり時々晴れ":      "Cloudy, occasionally sunny",
+	"くもり一時雨":       "Cloudy, brief rain",
+	"くもり時々雨":       "Cloudy, occasional rain",
+	"くもり一時雪":       "Cloudy, brief snow",
+	"くもり時々雪":       "Cloudy, occasional snow",
+	"くもり一時雨か雪":     "Cloudy, brief rain or snow",
+	"くもり時々雨か雪":     "Cloudy, occasional rain or snow",
+	"くもり一時雨か雷雨":    "Cloudy, brief rain or thunderstorms",
+	"霧":            "Fog",
+	"くもりのち時々晴れ":    "Cloudy, occasionally sunny later",
+	"くもりのち晴れ":      "Cloudy, sunny later",
+	"くもりのち一時雨":     "Cloudy, brief rain later",
+	"くもりのち時々雨":     "Cloudy, occasional rain later",
+	"くもりのち雨":       "Cloudy, rain later",
+	"くもりのち一時雪":     "Cloudy, brief snow later",
+	"くもりのち時々雪":     "Cloudy, occasional snow later",
+	"くもりのち雪":       "Cloudy, snow later",
+	"くもりのち雨か雪":     "Cloudy, rain or snow later",
+	"くもりのち雨か雷雨":    "Cloudy, rain or thunderstorms later",
+	"くもり朝夕一時雨":     "Cloudy, brief rain in the morning and evening",
+	"くもり朝の内一時雨":    "Cloudy, brief rain in the morning",
+	"くもり夕方一時雨":     "Cloudy, brief rain in the evening",
+	"くもり日中時々晴れ":    "Cloudy, occasionally sunny during the day",
+	"くもり昼頃から雨":     "Cloudy, rain from around noon",
+	"くもり夕方から雨":     "Cloudy, rain from the evening",
+	"くもり夜は雨":       "Cloudy, rain at night",
+	"くもり昼頃から雪":     "Cloudy, snow from around noon",
+	"くもり夕方から雪":     "Cloudy, snow from the evening",
+	"くもり夜は雪":       "Cloudy, snow at night",
+	"くもり海上海岸は霧か霧雨": "Cloudy, fog or drizzle at sea and on the coast",
+	"くもり時々雨で雷を伴う":  "Cloudy, occasional rain with thunder",
+	"くもり時々雪で雷を伴う":  "Cloudy, occasional snow with thunder",
+	"くもり一時雪か雨":     "Cloudy, brief snow or rain",
+	"くもり時々雪か雨":     "Cloudy, occasional snow or rain",
+	"くもりのち雪か雨":     "Cloudy, snow or rain later",
+	"雨":            "Rain",
+	"雨時々晴れ":        "Rain, occasionally sunny",
+	"雨時々止む":        "Rain, stopping at times",
+	"雨時々雪":         "Rain, occasional snow",
+	"雨か雪":          "Rain or snow",
+	"大雨":           "Heavy rain",
+	"雨で暴風を伴う":      "Rain with storm winds",
+	"雨一時雪":         "Rain, brief snow",
+	"雨のち晴れ":        "Rain, sunny later",
+	"雨のちくもり":       "Rain, cloudy later",
+	"雨のち時々雪":       "Rain, occasional snow later",
+	"雨のち雪":         "Rain, snow later",
+	"雨か雪のち晴れ":      "Rain or snow, sunny later",
+	"雨か雪のちくもり":     "Rain or snow, cloudy later",
+	"朝の内雨のち晴れ":     "Morning rain, sunny later",
+	"朝の内雨のちくもり":    "Morning rain, cloudy later",
+	"雨朝晩一時雪":       "Rain, brief snow in the morning and evening",
+	"雨昼頃から晴れ":      "Rain, sunny from around noon",
+	"雨夕方から晴れ":      "Rain, sunny from the evening",
+	"雨夜は晴れ":        "Rain, clear at night",
+	"雨夕方から雪":       "Rain, snow from the evening",
+	"雨夜は雪":         "Rain, snow at night",
+	"雨一時強く降る":      "Rain, heavy at times",
+	"雨一時みぞれ":       "Rain, brief sleet",
+	"雪か雨":          "Snow or rain",
+	"雨で雷を伴う":       "Rain with thunder",
+	"雪か雨のち晴れ":      "Snow or rain, sunny later",
+	"雪か雨のちくもり":     "Snow or rain, cloudy later",
+	"雪":            "Snow",
+	"雪時々晴れ":        "Snow, occasionally sunny",
+	"雪時々止む":        "Snow, stopping at times",
+	"雪時々雨":         "Snow, occasional rain",
+	"大雪":           "Heavy snow",
+	"風雪強い":         "Snow with strong winds",
+	"暴風雪":          "Snowstorm",
+	"雪一時雨":         "Snow, brief rain",
+	"雪のち晴れ":        "Snow, sunny later",
+	"雪のちくもり":       "Snow, cloudy later",
+	"雪のち雨":         "Snow, rain later",
+	"朝の内雪のち晴れ":     "Morning snow, sunny later",
+	"朝の内雪のちくもり":    "Morning snow, cloudy later",
+	"雪昼頃から雨":       "Snow, rain from around noon",
+	"雪夕方から雨":       "Snow, rain from the evening",
+	"雪一時強く降る":      "Snow, heavy at times",
+	"雪のちみぞれ":       "Snow, sleet later",
+	"雪一時みぞれ":       "Snow, brief sleet",
+	"雪で雷を伴う":       "Snow with thunder",
+	"みぞれ":          "Sleet",
+	"雷雨":           "Thunderstorm",
+	"時々":           "occasionally",
+	"一時":           "briefly",
+	"のち":           "later",
+	"不明な天気(%s)":    "Unknown weather (%s)",
+
+	"静穏":  "Calm",
+	"北北東": "NNE", "北東": "NE", "東北東": "ENE", "東": "E", "東南東": "ESE", "南東": "SE", "南南東": "SSE", "南": "S",
+	"南南西": "SSW", "南西": "SW", "西南西": "WSW", "西": "W", "西北西": "WNW", "北西": "NW", "北北西": "NNW", "北": "N",
+	"不明な風向(%d)": "Unknown wind direction (%d)",
+
+	"天気":      "Weather",
+	"降水確率":    "Precipitation",
+	"最高気温":    "High",
+	"最低気温":    "Low",
+	"最大風速":    "Max wind",
+	"最大風速時風向": "Max wind direction",
+	"気圧予報レベル": "Pressure level",
+	"最小湿度":    "Min humidity",
+
+	"%s (%s) の値 %v を変換できません: %w":         "%s (%s): cannot convert value %v: %w",
+	"予期しない型 %T です":                       "unexpected type %T",
+	"整数ではありません":                          "not an integer",
+	"APIDateTime %q のパースに失敗しました: %w":     "failed to parse APIDateTime %q: %w",
+	"時刻 %q のパースに失敗しました: %w":              "failed to parse time %q: %w",
+	"気圧 %q のパースに失敗しました: %w":              "failed to parse pressure %q: %w",
+	"気温 %q のパースに失敗しました: %w":              "failed to parse temperature %q: %w",
+	"CityCode は5桁の数字である必要があります、取得値: %s":  "CityCode must be 5 digits, got: %s",
+	"NameKata は半角カタカナである必要があります、取得値: %s": "NameKata must be half-width katakana, got: %s",
+	"RateNormal は非負である必要があります、取得値: %f":   "RateNormal must be non-negative, got: %f",
+	"RateLittle は非負である必要があります、取得値: %f":   "RateLittle must be non-negative, got: %f",
+	"RatePainful は非負である必要があります、取得値: %f":  "RatePainful must be non-negative, got: %f",
+	"RateBad は非負である必要があります、取得値: %f":      "RateBad must be non-negative, got: %f",
+	"Hour は 0 から 23 である必要があります、取得値: %d":  "Hour must be between 0 and 23, got: %d",
+	"PlaceID は3桁の数字である必要があります、取得値: %s":   "PlaceID must be 3 digits, got: %s",
+
+	// --- 表示 (presenter, chart) ---
+	"普通":               "Normal",
+	"少し痛い":             "Slightly painful",
+	"痛い":               "Painful",
+	"かなり痛い":            "Very painful",
+	"痛み":               "Pain",
+	"割合":               "Rate",
+	"地域コード":            "Code",
+	"地域名":              "Name",
+	"地域カナ":             "Kana",
+	"昨日":               "Yesterday",
+	"今日":               "Today",
+	"明日":               "Tomorrow",
+	"明後日":              "Day after tomorrow",
+	"時刻":               "Time",
+	"気温":               "Temp",
+	"気圧":               "Pressure",
+	"気圧レベル":            "Level",
+	"日付":               "Date",
+	"日時":               "Date/time",
+	"開始":               "Start",
+	"終了":               "End",
+	"時間数":              "Hours",
+	"最大レベル":            "Max level",
+	"変化量":              "Change",
+	"1時間":              "1h",
+	"3時間":              "3h",
+	"6時間":              "6h",
+	"24時間":             "24h",
+	"%d時":              "%d:00",
+	"%s時〜%s時":          "%s:00-%s:00",
+	"%sの痛み予報":          "Pain forecast for %s",
+	"%sの気圧予報":          "Pressure forecast for %s",
+	"<%s|%s>の気圧予報\n":   "<%s|%s> pressure forecast\n",
+	"<%s|%s>の気圧グラフ\n":  "<%s|%s> pressure graph\n",
+	"<%s|%s>の気圧変化分析\n": "<%s|%s> pressure change analysis\n",
+	"%sの気圧変化分析":        "Pressure change analysis for %s",
+	"%s (%s) の天気予報":    "Weather forecast for %s (%s)",
+	"「%s」の地点検索結果":      "Locations matching \"%s\"",
+	"「%s」に一致する地域が見つかりませんでした。":                                             "No locations matched \"%s\".",
+	"「%s」に一致する地域が見つかりませんでした。\n":                                           "No locations matched \"%s\".\n",
+	"データがありません。":                                                          "No data.",
+	"データがありません (%d時台)\n":                                                  "No data (from %d:00)\n",
+	"%s のデータがありません。\n":                                                    "No data for %s.\n",
+	"警告: %s のデータが24時間分ありません (%d時間分)。利用可能なデータを表示します。\n":                    "Warning: %s does not have 24 hours of data (%d hours). Showing the available data.\n",
+	"表示する天気情報要素がありません。":                                                   "There are no weather elements to display.",
+	"表示する天気情報要素がありません。\n":                                                 "There are no weather elements to display.\n",
+	"表示対象の日付が指定されていません。\n":                                                "No dates were specified for display.\n",
+	"表示するデータがありません。":                                                      "There is no data to display.",
+	"分析できる気圧データがありません。":                                                   "There is no pressure data to analyse.",
+	"最も急な気圧低下 (%d時間)":                                                     "Steepest pressure drop (%d hours)",
+	"最も急な気圧低下 (%d時間): %s 〜 %s %.1f → %.1f hPa (%+.1f hPa, %+.2f hPa/h)\n": "Steepest pressure drop (%d hours): %s - %s %.1f → %.1f hPa (%+.1f hPa, %+.2f hPa/h)\n",
+	"最も急な気圧低下 (%d時間): 気圧が低下する時間帯はありません\n":                                 "Steepest pressure drop (%d hours): the pressure does not drop\n",
+	"気圧が低下する時間帯はありません。":                                                   "The pressure does not drop.",
+	"リスク時間帯 (気圧レベルが%s以上、または3時間で%.1f hPa以上の低下)":                            "Risk periods (pressure level %s or higher, or a drop of %.1f hPa or more in 3 hours)",
+	"リスク時間帯 (気圧レベルが%s以上、または3時間で%.1f hPa以上の低下):\n":                         "Risk periods (pressure level %s or higher, or a drop of %.1f hPa or more in 3 hours):\n",
+	"該当する時間帯はありません":                                                       "None",
+	"該当する時間帯はありません。":                                                      "None.",
+	"3時間の最大低下":                                                            "Max 3h drop",
+	"1時間ごとの気圧変化":                                                          "Hourly pressure change",
+	"気圧 (hPa)":                                                            "Pressure (hPa)",
+	"気温 (℃)":                                                              "Temperature (℃)",
+	" (左)":                                                                " (left)",
+	" (右)":                                                                " (right)",
+	"現在":                                                                  "Now",
+	"無効な日付オフセットが提供されました: %d":                                              "invalid day offset: %d",
+	"グラフにする気圧データがありません":                                                   "there is no pressure data to plot",
+	"未対応の画像形式です: %s (%s のいずれかを指定してください)":                                  "unsupported image format: %s (use one of %s)",
+	"出力ファイルの拡張子から画像形式を判別できません: %s (.svg または .png を指定してください)": "cannot determine the image format from the file extension: %s (use .svg or .png)",
+	"SVG の書き込みに失敗しました: %w":              "failed to write SVG: %w",
+	"PNG の書き込みに失敗しました: %w":              "failed to write PNG: %w",
+	"CSV出力の書き込みに失敗しました: %w":             "failed to write CSV output: %w",
+	"HTML出力の書き込みに失敗しました: %w":            "failed to write HTML output: %w",
+	"Markdown出力の書き込みに失敗しました: %w":        "failed to write Markdown output: %w",
+	"NDJSON出力の書き込みに失敗しました: %w":          "failed to write NDJSON output: %w",
+	"JSON出力の書き込みに失敗しました: %w":            "failed to write JSON output: %w",
+	"YAML出力の書き込みに失敗しました: %w":            "failed to write YAML output: %w",
+	"データをJSONにマーシャリングできませんでした: %w":      "failed to marshal data to JSON: %w",
+	"データをYAMLに変換できませんでした: %w":           "failed to convert data to YAML: %w",
+	"テンプレートが指定されていません":                  "no template was specified",
+	"テンプレートファイルの読み込みに失敗しました: %w":        "failed to read the template file: %w",
+	"テンプレートの解析に失敗しました: %w":              "failed to parse the template: %w",
+	"テンプレートの実行に失敗しました: %w":              "failed to execute the template: %w",
+	"テンプレート出力の書き込みに失敗しました: %w":          "failed to write template output: %w",
+	"数値ではありません: %q":                     "not a number: %q",
+	"数値ではありません: %v":                     "not a number: %v",
+	"無効な出力形式です: %s (%s のいずれかを指定してください)": "invalid output format: %s (use one of %s)",
+	"無効な色の指定です: %s (%s のいずれかを指定してください)": "invalid colour mode: %s (use one of %s)",
+	"無効なテーマです: %s (%s のいずれかを指定してください)":  "invalid theme: %s (use one of %s)",
+	"無効な言語です: %s (%s のいずれかを指定してください)":   "invalid language: %s (use one of %s)",
+
+	// --- コマンド (commands, main) ---
+	"警告: キャッシュを使用せずに実行します: %v\n": "Warning: running without the cache: %v\n",
+	"警告: %v\n":         "Warning: %v\n",
+	"中断されました":          "Interrupted",
+	"結果の表示に失敗しました: %w": "failed to display the result: %w",
+	"内部エラー: プレゼンターが期待されるインターフェースを満たしていません": "internal error: the presenter does not implement the expected interface",
+
+	"地域コードまたは地域名を指定してください": "specify an area code or area name",
+	"無効な地域コードまたは地域名です: %s。有効な地域名（例：東京、大阪）または6桁の地域コード（例：130010）、または2桁の都道府県コード（例：13）を指定してください": "invalid area code or area name: %s. Specify a valid area name (e.g. 東京, 大阪), a 6-digit area code (e.g. 130010) or a 2-digit prefecture code (e.g. 13)",
+	"痛み予報の取得に失敗しました: %w":      "failed to fetch the pain forecast: %w",
+	"痛み予報の取得に失敗しました (%s): %w": "failed to fetch the pain forecast (%s): %w",
+
+	"検索キーワードを指定してください":                         "specify a search keyword",
+	"地域地点の検索に失敗しました: %w":                       "failed to search for locations: %w",
+	"地域地点の検索に失敗しました: %s (該当する地点が見つかりませんでした)\n": "failed to search for locations: %s (no matching location was found)\n",
+
+	"都市コードを指定してください":                              "specify a city code",
+	"都市コードまたは @name を指定してください":                    "specify a city code or @name",
+	"都市コードまたは都市名を指定してください":                        "specify a city code or city name",
+	"無効な都市コードまたは都市名です: '%s' (サポートされている値: %v)":     "invalid city code or city name: '%s' (supported values: %v)",
+	"無効な日付オフセットです: %d (0 から %d の間で指定してください)":      "invalid day offset: %d (must be between 0 and %d)",
+	"無効な日付オフセットです: %d (-1 から 2 の間で指定してください)":      "invalid day offset: %d (must be between -1 and 2)",
+	"-n オプションで表示する日付オフセットを指定してください (例: -n 0 で今日)": "specify the day offsets to display with -n (e.g. -n 0 for today)",
+	"利用可能な日付データがありません。":                           "No dates are available.",
+	"無効な予報日数です: %d (1 以上を指定してください)":               "invalid number of forecast days: %d (must be 1 or more)",
+	"無効な予報日数です: %d":                               "invalid number of forecast days: %d",
+	"気象状況の取得に失敗しました: %w":                          "failed to fetch the weather status: %w",
+	"気象状況の取得に失敗しました (%s): %w":                     "failed to fetch the weather status (%s): %w",
+	"Otenki ASP データの取得に失敗しました: %w":                "failed to fetch Otenki ASP data: %w",
+	"グラフの表示に失敗しました: %w":                           "failed to display the graph: %w",
+	"--graph は table 形式の出力でのみ使用できます":              "--graph can only be used with the table output format",
+	"出力ファイルの作成に失敗しました: %w":                        "failed to create the output file: %w",
+	"出力ファイルの書き込みに失敗しました: %w":                      "failed to write the output file: %w",
+	"出力ファイルの保存に失敗しました: %w":                        "failed to save the output file: %w",
+	"気圧グラフを %s に保存しました\n":                         "Saved the pressure graph to %s\n",
+
+	"無効な期間です: %d (1 から 24 の間で指定してください)":                         "invalid window: %d (must be between 1 and 24)",
+	"無効な気圧低下量です: %g (0 より大きい値を指定してください)":                        "invalid pressure drop: %g (must be greater than 0)",
+	"無効な気圧レベルです: %q (サポートされている値: %v)":                           "invalid pressure level: %q (supported values: %v)",
+	"無効な間隔です: %s (1分以上を指定してください)":                               "invalid interval: %s (must be at least 1 minute)",
+	"無効な期間です: %s (0 より大きく 72h 以下で指定してください)":                     "invalid period: %s (must be greater than 0 and at most 72h)",
+	"痛み予報の閾値は 0 から 100 の間で指定してください (0 で無効)":                     "pain forecast thresholds must be between 0 and 100 (0 disables them)",
+	"監視する地点がありません。'zutool location add' で地点を登録するか、地点名を指定してください": "there are no locations to watch. Register one with 'zutool location add' or specify a location",
+	"通知に失敗しました: %w": "failed to send the notification: %w",
+
+	"頭痛予報 (%s)": "Headache forecast (%s)",
+	"地点コード %s の週間予報は取得できません (Otenki ASP が対応している都市のみ)": "the weekly forecast is not available for city code %s (only cities supported by Otenki ASP)",
+	"無効な内容です: %q (サポートされている値: %s)":                    "invalid content: %q (supported values: %s)",
+	"--content で通知する内容を指定してください (サポートされている値: %s)":     "specify what to post with --content (supported values: %s)",
+	"ペイロードを整形できませんでした: %w":                            "failed to build the payload: %w",
+	"%s への投稿に失敗しました: %w":                              "failed to post to %s: %w",
+	"%s に投稿しました。\n":                                   "Posted to %s.\n",
+
+	"地点の検索に失敗しました (%s): %w":                   "failed to search for locations (%s): %w",
+	"'%s' に一致する地点が見つかりませんでした":                 "no location matched '%s'",
+	"'%s' に一致する地点が複数あります。地点コードで指定してください:\n%s": "several locations matched '%s'. Specify a city code:\n%s",
+	"'%s' に一致する地点が複数あります:\n%s":                "several locations matched '%s':\n%s",
+	"番号を選択してください (1-%d): ":                    "Choose a number (1-%d): ",
+	"地点が選択されませんでした: %w":                       "no location was chosen: %w",
+	"無効な番号です":                                 "Invalid number",
+
+	"設定キーと値を指定してください (例: config set timeout 20s)":                          "specify a config key and value (e.g. config set timeout 20s)",
+	"%s = %s を設定しました (%s)\n":                                               "Set %s = %s (%s)\n",
+	"地点名を指定してください (例: location add home --city 13113)":                     "specify a location name (e.g. location add home --city 13113)",
+	"地点 '%s' を登録しました (地点コード: %s, 地域コード: %s)\n":                             "Registered location '%s' (city code: %s, area code: %s)\n",
+	"削除する地点名を指定してください":                                                     "specify the location to remove",
+	"地点 '%s' を削除しました\n":                                                    "Removed location '%s'\n",
+	"デフォルトの地点は設定されていません":                                                   "No default location is set",
+	"デフォルトの地点を '%s' に設定しました\n":                                             "Set the default location to '%s'\n",
+	"登録されている地点はありません ('zutool location add <name> --city <code>' で登録できます)": "No locations are registered (register one with 'zutool location add <name> --city <code>')",
+	"名前":    "Name",
+	"地点コード": "City code",
+	"デフォルト": "Default",
+
+	"%d 件のキャッシュを削除しました (%s)\n": "Removed %d cache entries (%s)\n",
+	"キャッシュディレクトリ: %s\n":        "Cache directory: %s\n",
+	"エンドポイント":                  "Endpoint",
+	"件数":                       "Entries",
+	"期限切れ":                     "Expired",
+	"サイズ (バイト)":                "Size (bytes)",
+
+	// --- 設定 (config) ---
+	"設定ディレクトリを特定できませんでした: %w":             "cannot determine the config directory: %w",
+	"設定ディレクトリの作成に失敗しました: %w":              "failed to create the config directory: %w",
+	"設定ファイルの書き込みに失敗しました: %w":              "failed to write the config file: %w",
+	"設定ファイルの読み込みに失敗しました: %w":              "failed to read the config file: %w",
+	"設定ファイル %s の解析に失敗しました: %w":            "failed to parse the config file %s: %w",
+	"設定ファイル %s の format が無効です: %s":        "invalid format in the config file %s: %s",
+	"設定ファイル %s の color が無効です: %s":         "invalid color in the config file %s: %s",
+	"設定ファイル %s の theme が無効です: %s":         "invalid theme in the config file %s: %s",
+	"設定ファイル %s の lang が無効です: %s":          "invalid lang in the config file %s: %s",
+	"設定のマーシャリングに失敗しました: %w":               "failed to marshal the config: %w",
+	"環境変数 %s の値が不正です: %w":                 "invalid value in environment variable %s: %w",
+	"1 以上の整数を指定してください: %s":                "specify an integer of 1 or more: %s",
+	"true または false を指定してください: %s":        "specify true or false: %s",
+	"不明な設定キーです: %s (有効なキー: %s)":           "unknown config key: %s (valid keys: %s)",
+	"0 以上の期間 (例: 10s, 5m) を指定してください: %s":  "specify a non-negative duration (e.g. 10s, 5m): %s",
+	"地点コードは数字で指定してください (例: 13113): '%s'":  "the city code must be numeric (e.g. 13113): '%s'",
+	"地域コードは数字で指定してください (例: 13): '%s'":     "the area code must be numeric (e.g. 13): '%s'",
+	"地点 '%s' は登録されていません (登録済み: %s)":       "location '%s' is not registered (registered: %s)",
+	"無効な地点名です: '%s' (空白や先頭の %s は使用できません)": "invalid location name: '%s' (whitespace and a leading %s are not allowed)",
+
+	// --- キャッシュ (cache) ---
+	"キャッシュディレクトリを特定できませんでした: %w": "cannot determine the cache directory: %w",
+	"キャッシュディレクトリの作成に失敗しました: %w":  "failed to create the cache directory: %w",
+	"キャッシュのマーシャリングに失敗しました: %w":   "failed to marshal the cache entry: %w",
+	"キャッシュファイルの作成に失敗しました: %w":    "failed to create the cache file: %w",
+	"キャッシュファイルの書き込みに失敗しました: %w":  "failed to write the cache file: %w",
+	"キャッシュファイルの保存に失敗しました: %w":    "failed to save the cache file: %w",
+	"キャッシュの削除に失敗しました: %w":        "failed to clear the cache: %w",
+	"キャッシュの集計に失敗しました: %w":        "failed to summarise the cache: %w",
+
+	// --- 通知 (notify) ---
+	"%s: 気圧レベル%s": "%s: pressure level %s",
+	"%sで%s〜%sに気圧レベルが%sになる予報です。": "In %s, the pressure level is forecast to be %s-%s: %s.",
+	"%s: 頭痛に注意": "%s: headache warning",
+	"%sの%s時〜%s時の痛み予報: 痛い %.0f%%、かなり痛い %.0f%%": "Pain forecast for %s, %s:00-%s:00: painful %.0f%%, very painful %.0f%%",
+	"期間":                         "Period",
+	"%sの気圧予報 (%s)":               "Pressure forecast for %s (%s)",
+	"%sの気圧予報 (%s %s)":            "Pressure forecast for %s (%s %s)",
+	"データがありません":                  "No data",
+	"最大の気圧レベル: %s":               "Max pressure level: %s",
+	"最も急な気圧低下: %s〜%s (%+.1fhPa)": "Steepest pressure drop: %s-%s (%+.1fhPa)",
+	"%sの週間予報":                    "Weekly forecast for %s",
+	"降水%d%%":                     "Precip. %d%%",
+	"頭痛:%s":                      "Headache: %s",
+	"不明なチャットの形式です: %q (サポートされている値: %s)":                  "unknown chat format: %q (supported values: %s)",
+	"不明なチャットの形式です: %q":                                   "unknown chat format: %q",
+	"%s の Webhook には http(s) の URL を指定してください: %q":        "the %s webhook requires an http(s) URL: %q",
+	"%s のペイロードを作成できませんでした: %w":                           "failed to build the %s payload: %w",
+	"通知の状態ファイルを読み込めませんでした: %w":                           "failed to read the notification state file: %w",
+	"通知の状態ファイルを解析できませんでした (%s): %w":                      "failed to parse the notification state file (%s): %w",
+	"通知の状態をマーシャリングできませんでした: %w":                          "failed to marshal the notification state: %w",
+	"通知の状態ファイルのディレクトリを作成できませんでした: %w":                    "failed to create the notification state directory: %w",
+	"通知の状態ファイルを保存できませんでした: %w":                           "failed to save the notification state file: %w",
+	"通知の出力に失敗しました: %w":                                   "failed to print the notification: %w",
+	"通知をJSONにマーシャリングできませんでした: %w":                        "failed to marshal the notification to JSON: %w",
+	"通知コマンド %q の実行に失敗しました: %w":                           "failed to run the notification command %q: %w",
+	"Webhook リクエストの作成に失敗しました: %w":                        "failed to create the webhook request: %w",
+	"Webhook への送信に失敗しました: %w":                            "failed to send to the webhook: %w",
+	"Webhook がステータス %s を返しました":                           "the webhook returned status %s",
+	"デスクトップ通知に失敗しました: %w (%s)":                           "desktop notification failed: %w (%s)",
+	"exec の通知先にはコマンドを指定してください (例: exec:/path/to/script)": "the exec sink requires a command (e.g. exec:/path/to/script)",
+	"webhook の通知先には http(s) の URL を指定してください: %q":         "the webhook sink requires an http(s) URL: %q",
+	"不明な通知先です: %q (サポートされている値: %s)":                      "unknown sink: %q (supported values: %s)",
+
+	// --- API クライアント (api) ---
+	"%w (試行回数: %d)": "%w (attempts: %d)",
+	"リトライ待機中に中断されました (試行回数: %d): %w":                        "interrupted while waiting to retry (attempts: %d): %w",
+	"リクエストの実行に失敗しました: %w":                                   "request failed: %w",
+	"レスポンスボディの読み込みに失敗しました: %w":                              "failed to read the response body: %w",
+	"%s のリクエスト作成に失敗しました: %w":                                "failed to create the request for %s: %w",
+	"%s のリクエストに失敗しました: %w":                                  "request to %s failed: %w",
+	"痛み指数情報の取得に失敗しました: %w":                                  "failed to fetch the pain index: %w",
+	"GetPainStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s":       "failed to unmarshal the GetPainStatus response: %w, body: %s",
+	"setweatherpointリクエストの作成に失敗しました: %w":                    "failed to create the setweatherpoint request: %w",
+	"地点コード '%s' が見つかりません (setweatherpoint failed with 404)": "city code '%s' was not found (setweatherpoint failed with 404)",
+	"setweatherpointリクエストに失敗しました: %w":                       "setweatherpoint request failed: %w",
+	"setweatherpointレスポンスのアンマーシャルに失敗しました: %w, body: %s":     "failed to unmarshal the setweatherpoint response: %w, body: %s",
+	"setweatherpointレスポンスが 'ok' ではありませんでした: %s":             "the setweatherpoint response was not 'ok': %s",
+	"/getweatherpoint のリクエストに失敗しました: %w":                    "/getweatherpoint request failed: %w",
+	"GetWeatherStatusレスポンスのアンマーシャルに失敗しました: %w, body: %s":    "failed to unmarshal the GetWeatherStatus response: %w, body: %s",
+	"Otenki ASP URLのパースに失敗しました: %w":                         "failed to parse the Otenki ASP URL: %w",
+	"Otenki ASPリクエストの作成に失敗しました: %w":                         "failed to create the Otenki ASP request: %w",
+	"Otenki ASPリクエストに失敗しました: %w":                            "Otenki ASP request failed: %w",
+	"Otenki ASP レスポンスの日別予報への変換に失敗しました: %w":                  "failed to convert the Otenki ASP response to daily forecasts: %w",
+	"ステータス: %d":        "status: %d",
+	"%s, 試行回数: %d":     "%s, attempts: %d",
+	"APIエラー: %s (%s)":  "API error: %s (%s)",
+	"APIエラー (%s): %v":  "API error (%s): %v",
+	"APIエラー (%s): %s":  "API error (%s): %s",
+	"%s '%s' が見つかりません": "%s '%s' was not found",
+	"/getweatherpoint の初期レスポンスのアンマーシャルに失敗しました: %w, body: %s":                  "failed to unmarshal the initial /getweatherpoint response: %w, body: %s",
+	"/getweatherpoint のネストされたresult文字列のアンマーシャルに失敗しました: %w, result string: %s": "failed to unmarshal the nested result string of /getweatherpoint: %w, result string: %s",
+	"Otenki ASP 生レスポンスのアンマーシャルに失敗しました: %w, body: %s":                          "failed to unmarshal the raw Otenki ASP response: %w, body: %s",
+	"レコード数が不足しているため、生の要素をスキップします (ヘッダー + データが必要です): %+v\n":                    "Skipping a raw element with too few records (a header and data are required): %+v\n",
+	"ヘッダープロパティの構造が無効なため、要素をスキップします (ContentID + Titleが必要です): %+v\n":           "Skipping an element with an invalid header property (ContentID and Title are required): %+v\n",
+	"ヘッダーのContentIDまたはTitleの型が無効なため、要素をスキップします: %+v\n":                        "Skipping an element whose header ContentID or Title has an invalid type: %+v\n",
+	"データプロパティの構造が無効なため、スキップします (時刻 + 値が必要です): %+v\n":                          "Skipping a data record with an invalid property (a time and a value are required): %+v\n",
+	"時刻が文字列でないデータレコードをスキップします: %v\n":                                          "Skipping a data record whose time is not a string: %v\n",
+	"時刻 '%s' をパースできないため、データレコードをスキップします: 試行したフォーマット %v\n":                     "Skipping a data record because time '%s' cannot be parsed: tried formats %v\n",
+	"記録ファイルの読み込みに失敗しました: %w":                                                  "failed to read the recording: %w",
+	"記録ファイル %s のアンマーシャルに失敗しました: %w":                                           "failed to unmarshal the recording %s: %w",
+	"記録のためのレスポンスボディの読み込みに失敗しました: %w":                                          "failed to read the response body for recording: %w",
+	"記録のマーシャリングに失敗しました: %w":                                                   "failed to marshal the recording: %w",
+	"記録ディレクトリの作成に失敗しました: %w":                                                  "failed to create the recording directory: %w",
+	"記録ファイルの書き込みに失敗しました: %w":                                                  "failed to write the recording: %w",
+	"前回の記録ファイルの削除に失敗しました: %w":                                                 "failed to remove a previous recording: %w",
+	"記録されたレスポンスがありません: %w":                                                    "no recorded response: %w",
+}
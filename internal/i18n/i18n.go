@@ -0,0 +1,93 @@
+// Package i18n は利用者に表示するメッセージ (表示名・見出し・エラーメッセージ) の翻訳を提供します。
+//
+// メッセージは日本語の原文をそのままキーとして各言語のカタログ (例: catalog_en.go) から翻訳を引きます。
+// 翻訳が無いメッセージや言語が Ja の場合は原文を返すため、呼び出し側は日本語のメッセージを i18n.T などで囲むだけで済みます。
+// 言語はコマンドの実行前に SetLang で一度だけ設定します。
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lang はメッセージの言語です。
+type Lang string
+
+const (
+	Ja Lang = "ja" // 日本語 (既定)
+	En Lang = "en" // 英語
+)
+
+// Langs は指定できる言語の一覧です。
+var Langs = []string{string(Ja), string(En)}
+
+// current は現在の言語です。
+var current = Ja
+
+// catalogs は言語ごとの、日本語の原文から翻訳へのマップです。
+var catalogs = map[Lang]map[string]string{
+	En: en,
+}
+
+// SetLang はメッセージの言語を設定します。
+func SetLang(lang Lang) {
+	current = lang
+}
+
+// Current は現在のメッセージの言語を返します。
+func Current() Lang {
+	return current
+}
+
+// Parse は文字列 (Langs のいずれか) を Lang に変換します。
+func Parse(s string) (Lang, error) {
+	switch lang := Lang(s); lang {
+	case Ja, En:
+		return lang, nil
+	default:
+		return "", Errorf("無効な言語です: %s (%s のいずれかを指定してください)", s, strings.Join(Langs, ", "))
+	}
+}
+
+// LocaleEnvs は言語の判定に使う環境変数です。先に設定されているものを優先します。
+var LocaleEnvs = []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+
+// Detect は getenv (通常は os.Getenv) から LocaleEnvs のうち最初に設定されているロケール (例: "en_US.UTF-8") を読み取り、言語を判定します。
+// ロケールが設定されていない場合や C・POSIX、日本語の場合は Ja を、それ以外の言語の場合は En を返します。
+func Detect(getenv func(string) string) Lang {
+	for _, name := range LocaleEnvs {
+		locale := getenv(name)
+		if locale == "" {
+			continue
+		}
+		// "ja_JP.UTF-8" や "en_US@euro" から言語の部分だけを取り出す
+		language, _, _ := strings.Cut(locale, ".")
+		language, _, _ = strings.Cut(language, "@")
+		language, _, _ = strings.Cut(language, "_")
+		switch strings.ToLower(language) {
+		case "c", "posix", string(Ja):
+			return Ja
+		default:
+			return En
+		}
+	}
+	return Ja
+}
+
+// T は日本語のメッセージ msg を現在の言語に翻訳します。翻訳が無い場合は msg をそのまま返します。
+func T(msg string) string {
+	if translated, ok := catalogs[current][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// Sprintf は書式 format を翻訳してから fmt.Sprintf と同様に整形します。
+func Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Errorf は書式 format を翻訳してから fmt.Errorf と同様にエラーを作成します。%w でラップしたエラーは errors.Is などで取り出せます。
+func Errorf(format string, args ...any) error {
+	return fmt.Errorf(T(format), args...)
+}
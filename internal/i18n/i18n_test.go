@@ -0,0 +1,141 @@
+package i18n
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useLang はテストの間だけ言語を lang にします。
+func useLang(t *testing.T, lang Lang) {
+	t.Helper()
+	SetLang(lang)
+	t.Cleanup(func() { SetLang(Ja) })
+}
+
+func TestParse(t *testing.T) {
+	lang, err := Parse("en")
+	require.NoError(t, err)
+	assert.Equal(t, En, lang)
+	lang, err = Parse("ja")
+	require.NoError(t, err)
+	assert.Equal(t, Ja, lang)
+	_, err = Parse("fr")
+	assert.Error(t, err)
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Lang
+	}{
+		{"未設定", nil, Ja},
+		{"日本語", map[string]string{"LANG": "ja_JP.UTF-8"}, Ja},
+		{"英語", map[string]string{"LANG": "en_US.UTF-8"}, En},
+		{"その他の言語は英語", map[string]string{"LANG": "de_DE@euro"}, En},
+		{"C ロケール", map[string]string{"LANG": "C.UTF-8"}, Ja},
+		{"LC_ALL が優先", map[string]string{"LC_ALL": "en_GB.UTF-8", "LANG": "ja_JP.UTF-8"}, En},
+		{"LC_MESSAGES が LANG より優先", map[string]string{"LC_MESSAGES": "ja_JP", "LANG": "en_US"}, Ja},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Detect(func(name string) string { return tt.env[name] }))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "警戒", T("警戒"), "日本語の場合は原文のままのはずです")
+
+	useLang(t, En)
+	assert.Equal(t, "Alert", T("警戒"))
+	assert.Equal(t, "翻訳の無いメッセージ", T("翻訳の無いメッセージ"), "翻訳が無い場合は原文のままのはずです")
+	assert.Equal(t, "3:00", Sprintf("%d時", 3))
+
+	cause := errors.New("cause")
+	err := Errorf("通知に失敗しました: %w", cause)
+	assert.Equal(t, "failed to send the notification: cause", err.Error())
+	assert.ErrorIs(t, err, cause, "%w でラップしたエラーを取り出せるはずです")
+}
+
+// verbPattern は書式指定子 (%% を除く) に一致します。
+var verbPattern = regexp.MustCompile(`%[-+# 0]*[0-9.]*[a-zA-Z]`)
+
+func TestCatalogVerbs(t *testing.T) {
+	for msg, translated := range en {
+		want := verbPattern.FindAllString(strings.ReplaceAll(msg, "%%", ""), -1)
+		got := verbPattern.FindAllString(strings.ReplaceAll(translated, "%%", ""), -1)
+		assert.Equal(t, want, got, "%q の翻訳は原文と同じ書式指定子を同じ順序で含むはずです", msg)
+	}
+}
+
+// TestCatalogCoverage はモジュール内で T、Sprintf、Errorf に渡している全ての日本語のメッセージに英語の翻訳があることを確認します。
+func TestCatalogCoverage(t *testing.T) {
+	japanese := regexp.MustCompile(`[\p{Hiragana}\p{Katakana}\p{Han}]`)
+	root := filepath.Join("..", "..")
+	var checked int
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != root && (strings.HasPrefix(d.Name(), ".") || d.Name() == "testdata") {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		inPackage := file.Name.Name == "i18n"
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 || !isCatalogCall(call.Fun, inPackage) {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			msg, err := strconv.Unquote(lit.Value)
+			if err != nil || !japanese.MatchString(msg) {
+				return true
+			}
+			checked++
+			_, ok = en[msg]
+			assert.True(t, ok, "%s: %q の英語の翻訳がありません", path, msg)
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, checked, "翻訳するメッセージが見つかるはずです")
+}
+
+// isCatalogCall は fun が i18n.T、i18n.Sprintf、i18n.Errorf (i18n パッケージ内では T、Sprintf、Errorf) かどうかを返します。
+func isCatalogCall(fun ast.Expr, inPackage bool) bool {
+	name := ""
+	switch f := fun.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := f.X.(*ast.Ident); ok && pkg.Name == "i18n" {
+			name = f.Sel.Name
+		}
+	case *ast.Ident:
+		if inPackage {
+			name = f.Name
+		}
+	}
+	return name == "T" || name == "Sprintf" || name == "Errorf"
+}
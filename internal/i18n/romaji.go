@@ -0,0 +1,224 @@
+package i18n
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// このファイルは英語表示で地名をローマ字にするための変換を定義します。
+// 都道府県名と主要な都市名は placeNames の表で、それ以外の地点はカタカナの読み (models.WeatherPoint.NameKata) をヘボン式に変換します。
+
+// placeNames は都道府県名 (「都」「府」「県」を除く) と主要な都市名のローマ字表記です。
+var placeNames = map[string]string{
+	"北海道": "Hokkaido", "青森": "Aomori", "岩手": "Iwate", "宮城": "Miyagi", "秋田": "Akita",
+	"山形": "Yamagata", "福島": "Fukushima", "茨城": "Ibaraki", "栃木": "Tochigi", "群馬": "Gunma",
+	"埼玉": "Saitama", "千葉": "Chiba", "東京": "Tokyo", "神奈川": "Kanagawa", "新潟": "Niigata",
+	"富山": "Toyama", "石川": "Ishikawa", "福井": "Fukui", "山梨": "Yamanashi", "長野": "Nagano",
+	"岐阜": "Gifu", "静岡": "Shizuoka", "愛知": "Aichi", "三重": "Mie", "滋賀": "Shiga",
+	"京都": "Kyoto", "大阪": "Osaka", "兵庫": "Hyogo", "奈良": "Nara", "和歌山": "Wakayama",
+	"鳥取": "Tottori", "島根": "Shimane", "岡山": "Okayama", "広島": "Hiroshima", "山口": "Yamaguchi",
+	"徳島": "Tokushima", "香川": "Kagawa", "愛媛": "Ehime", "高知": "Kochi", "福岡": "Fukuoka",
+	"佐賀": "Saga", "長崎": "Nagasaki", "熊本": "Kumamoto", "大分": "Oita", "宮崎": "Miyazaki",
+	"鹿児島": "Kagoshima", "沖縄": "Okinawa",
+	// Otenki ASP で確認済みの都市 (models.ConfirmedOtenkiAspCityCodeMap)
+	"札幌": "Sapporo", "仙台": "Sendai", "金沢": "Kanazawa", "名古屋": "Nagoya", "那覇": "Naha",
+}
+
+// placeSuffixes は placeNames を引く前に取り除く地名の接尾辞です。
+var placeSuffixes = []string{"都", "府", "県", "市"}
+
+// PlaceName は英語表示の場合に地名 (例: "東京都") をローマ字 (例: "Tokyo") にします。
+// 日本語表示の場合や、ローマ字表記が分からない地名の場合はそのまま返します。
+func PlaceName(name string) string {
+	if current != En {
+		return name
+	}
+	if romaji, ok := lookupPlace(name); ok {
+		return romaji
+	}
+	return name
+}
+
+// PointName は英語表示の場合に地点名 (例: "東京都渋谷区") をカタカナの読み kana (例: "ｼﾌﾞﾔｸ") からローマ字 (例: "Shibuya-ku, Tokyo") にします。
+// 日本語表示の場合や読みが無い場合は PlaceName と同じです。
+func PointName(name, kana string) string {
+	if current != En || kana == "" {
+		return PlaceName(name)
+	}
+	if romaji, ok := lookupPlace(name); ok {
+		return romaji
+	}
+	romaji := hyphenateSuffix(name, RomanizeKana(kana))
+	if prefecture, ok := prefectureOf(name); ok {
+		return romaji + ", " + prefecture
+	}
+	return romaji
+}
+
+// prefectureOf は地点名 name の先頭にある都道府県名のローマ字表記を返します。
+func prefectureOf(name string) (string, bool) {
+	if strings.HasPrefix(name, "北海道") {
+		return placeNames["北海道"], true
+	}
+	for prefecture, romaji := range placeNames {
+		for _, suffix := range []string{"都", "府", "県"} {
+			if strings.HasPrefix(name, prefecture+suffix) {
+				return romaji, true
+			}
+		}
+	}
+	return "", false
+}
+
+// lookupPlace は placeNames から name または接尾辞を除いた name のローマ字表記を探します。
+func lookupPlace(name string) (string, bool) {
+	if romaji, ok := placeNames[name]; ok {
+		return romaji, true
+	}
+	for _, suffix := range placeSuffixes {
+		if base, ok := strings.CutSuffix(name, suffix); ok {
+			if romaji, ok := placeNames[base]; ok {
+				return romaji, true
+			}
+		}
+	}
+	return "", false
+}
+
+// municipalSuffixes は市区町村名の接尾辞とその読みです。
+var municipalSuffixes = []struct{ kanji, romaji string }{
+	{"区", "ku"}, {"市", "shi"}, {"町", "machi"}, {"町", "cho"}, {"村", "mura"}, {"村", "son"},
+}
+
+// hyphenateSuffix は地点名 name が市区町村の接尾辞で終わる場合に、ローマ字の読みの接尾辞をハイフンで区切ります (例: "Shibuyaku" → "Shibuya-ku")。
+func hyphenateSuffix(name, romaji string) string {
+	for _, s := range municipalSuffixes {
+		if strings.HasSuffix(name, s.kanji) && strings.HasSuffix(romaji, s.romaji) && len(romaji) > len(s.romaji) {
+			return romaji[:len(romaji)-len(s.romaji)] + "-" + s.romaji
+		}
+	}
+	return romaji
+}
+
+// halfWidthKana は半角カタカナ (U+FF66〜U+FF9D) に対応する全角カタカナです。
+const halfWidthKana = "ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン"
+
+// voicedKana は濁点 (半角の ﾞ) を付けられるカタカナです。濁音は元の文字の次のコードポイントです (例: カ → ガ)。
+const voicedKana = "カキクケコサシスセソタチツテトハヒフヘホ"
+
+// semiVoicedKana は半濁点 (半角の ﾟ) を付けられるカタカナです。半濁音は元の文字の2つ後のコードポイントです (例: ハ → パ)。
+const semiVoicedKana = "ハヒフヘホ"
+
+// toFullWidthKana は半角カタカナを全角カタカナにし、半角の濁点・半濁点を直前の文字と合成します。
+func toFullWidthKana(s string) []rune {
+	table := []rune(halfWidthKana)
+	var out []rune
+	for _, r := range s {
+		n := len(out)
+		switch {
+		case r == 'ﾞ' && n > 0 && out[n-1] == 'ウ':
+			out[n-1] = 'ヴ'
+		case r == 'ﾞ' && n > 0 && strings.ContainsRune(voicedKana, out[n-1]):
+			out[n-1]++
+		case r == 'ﾟ' && n > 0 && strings.ContainsRune(semiVoicedKana, out[n-1]):
+			out[n-1] += 2
+		case r >= 'ｦ' && r <= 'ﾝ':
+			out = append(out, table[r-'ｦ'])
+		default:
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// kanaRomaji はカタカナ1文字のヘボン式の読みです。
+var kanaRomaji = map[rune]string{
+	'ア': "a", 'イ': "i", 'ウ': "u", 'エ': "e", 'オ': "o",
+	'カ': "ka", 'キ': "ki", 'ク': "ku", 'ケ': "ke", 'コ': "ko",
+	'ガ': "ga", 'ギ': "gi", 'グ': "gu", 'ゲ': "ge", 'ゴ': "go",
+	'サ': "sa", 'シ': "shi", 'ス': "su", 'セ': "se", 'ソ': "so",
+	'ザ': "za", 'ジ': "ji", 'ズ': "zu", 'ゼ': "ze", 'ゾ': "zo",
+	'タ': "ta", 'チ': "chi", 'ツ': "tsu", 'テ': "te", 'ト': "to",
+	'ダ': "da", 'ヂ': "ji", 'ヅ': "zu", 'デ': "de", 'ド': "do",
+	'ナ': "na", 'ニ': "ni", 'ヌ': "nu", 'ネ': "ne", 'ノ': "no",
+	'ハ': "ha", 'ヒ': "hi", 'フ': "fu", 'ヘ': "he", 'ホ': "ho",
+	'バ': "ba", 'ビ': "bi", 'ブ': "bu", 'ベ': "be", 'ボ': "bo",
+	'パ': "pa", 'ピ': "pi", 'プ': "pu", 'ペ': "pe", 'ポ': "po",
+	'マ': "ma", 'ミ': "mi", 'ム': "mu", 'メ': "me", 'モ': "mo",
+	'ヤ': "ya", 'ユ': "yu", 'ヨ': "yo",
+	'ラ': "ra", 'リ': "ri", 'ル': "ru", 'レ': "re", 'ロ': "ro",
+	'ワ': "wa", 'ヲ': "o", 'ン': "n", 'ヴ': "vu",
+}
+
+// smallKana は拗音などで直前の文字と組み合わせる小書きのカタカナの母音です。
+var smallKana = map[rune]string{
+	'ャ': "a", 'ュ': "u", 'ョ': "o",
+	'ァ': "a", 'ィ': "i", 'ゥ': "u", 'ェ': "e", 'ォ': "o",
+}
+
+// RomanizeKana は全角または半角のカタカナ (例: "ｼﾌﾞﾔｸ") をヘボン式のローマ字 (例: "Shibuyaku") にします。
+// 地名の表記に合わせて長音は書き表さず ("ｺｳﾍﾞ" → "Kobe")、先頭の文字を大文字にします。カタカナ以外の文字はそのまま残します。
+func RomanizeKana(kana string) string {
+	var syllables []string
+	geminate := false // 直前が促音 (ッ)
+	for _, r := range toFullWidthKana(kana) {
+		n := len(syllables)
+		prev := ""
+		if n > 0 {
+			prev = syllables[n-1]
+		}
+		if vowel, ok := smallKana[r]; ok && prev != "" {
+			switch {
+			case r == 'ャ' || r == 'ュ' || r == 'ョ':
+				// キャ → kya、シャ → sha
+				base := strings.TrimSuffix(prev, "i")
+				if !strings.HasSuffix(base, "sh") && !strings.HasSuffix(base, "ch") && !strings.HasSuffix(base, "j") {
+					base += "y"
+				}
+				syllables[n-1] = base + vowel
+			case prev == "u":
+				// ウィ → wi
+				syllables[n-1] = "w" + vowel
+			default:
+				// ファ → fa、ティ → ti
+				syllables[n-1] = prev[:len(prev)-1] + vowel
+			}
+			continue
+		}
+		switch r {
+		case 'ッ':
+			geminate = true
+			continue
+		case 'ー':
+			continue
+		case 'ウ':
+			// 長音は書き表さない (コウ → ko、ユウ → yu)
+			if strings.HasSuffix(prev, "o") || strings.HasSuffix(prev, "u") {
+				continue
+			}
+		case 'オ':
+			if strings.HasSuffix(prev, "o") {
+				continue
+			}
+		}
+		syllable, ok := kanaRomaji[r]
+		if !ok {
+			syllable = string(r)
+		}
+		if geminate {
+			// ッチ → tchi、ッカ → kka
+			if strings.HasPrefix(syllable, "ch") {
+				syllable = "t" + syllable
+			} else if first, _ := utf8.DecodeRuneInString(syllable); first < utf8.RuneSelf && !strings.ContainsRune("aiueon", first) {
+				syllable = string(first) + syllable
+			}
+			geminate = false
+		}
+		syllables = append(syllables, syllable)
+	}
+	romaji := strings.Join(syllables, "")
+	if romaji == "" {
+		return ""
+	}
+	return strings.ToUpper(romaji[:1]) + romaji[1:]
+}
@@ -0,0 +1,48 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRomanizeKana(t *testing.T) {
+	tests := map[string]string{
+		"ｼﾌﾞﾔｸ":    "Shibuyaku",
+		"ｺｳﾍﾞｼ":    "Kobeshi",
+		"ﾄｳｷｮｳ":    "Tokyo",
+		"ｵｵｻｶ":     "Osaka",
+		"ｻｯﾎﾟﾛ":    "Sapporo",
+		"ﾊｯﾁｮｳﾎﾞﾘ": "Hatchobori",
+		"ｼﾞｭｳｼﾞｮｳ": "Jujo",
+		"ﾁｬﾀﾝ":     "Chatan",
+		"ｳﾞｨｰﾅｽ":   "Vinasu",
+		"シンジュク":    "Shinjuku",
+		"":         "",
+	}
+	for kana, want := range tests {
+		assert.Equal(t, want, RomanizeKana(kana), kana)
+	}
+}
+
+func TestPlaceName(t *testing.T) {
+	assert.Equal(t, "東京都", PlaceName("東京都"), "日本語の場合はそのままのはずです")
+
+	useLang(t, En)
+	assert.Equal(t, "Tokyo", PlaceName("東京都"))
+	assert.Equal(t, "Hokkaido", PlaceName("北海道"))
+	assert.Equal(t, "Kyoto", PlaceName("京都府"))
+	assert.Equal(t, "Sapporo", PlaceName("札幌"))
+	assert.Equal(t, "渋谷区", PlaceName("渋谷区"), "ローマ字表記が分からない地名はそのままのはずです")
+}
+
+func TestPointName(t *testing.T) {
+	assert.Equal(t, "東京都渋谷区", PointName("東京都渋谷区", "ｼﾌﾞﾔｸ"), "日本語の場合はそのままのはずです")
+
+	useLang(t, En)
+	assert.Equal(t, "Shibuya-ku, Tokyo", PointName("東京都渋谷区", "ｼﾌﾞﾔｸ"))
+	assert.Equal(t, "Kobe-shi, Hyogo", PointName("兵庫県神戸市", "ｺｳﾍﾞｼ"))
+	assert.Equal(t, "Sapporo-shi, Hokkaido", PointName("北海道札幌市", "ｻｯﾎﾟﾛｼ"))
+	assert.Equal(t, "Osaka", PointName("大阪府", "ｵｵｻｶﾌ"), "都道府県そのものは表のローマ字表記のはずです")
+	assert.Equal(t, "東京都渋谷区", PointName("東京都渋谷区", ""), "読みが無い場合はそのままのはずです")
+}
@@ -1,14 +1,6 @@
 package models
 
-import "fmt"
-
-// WeatherEmojiMap は天気コード (簡略化) を絵文字にマッピングします。
-var WeatherEmojiMap = map[int]string{
-	100: "☀", // 晴れ
-	200: "☁", // くもり
-	300: "☔", // 雨
-	400: "🌨", // 雪
-}
+import "github.com/eraiza0816/zu2l/internal/i18n"
 
 // ConfirmedOtenkiAspCityCodeMap は Otenki ASP で確認済みの都市コードとその名称をマッピングします。
 var ConfirmedOtenkiAspCityCodeMap = map[string]string{
@@ -144,147 +136,16 @@ const (
 func (p PressureLevelEnum) String() string {
 	switch p {
 	case Normal:
-		return "通常"
+		return i18n.T("通常")
 	case SlightAlert:
-		return "やや注意"
+		return i18n.T("やや注意")
 	case Caution:
-		return "注意"
+		return i18n.T("注意")
 	case Alert:
-		return "警戒"
+		return i18n.T("警戒")
 	case SevereAlert:
-		return "厳重警戒"
-	default:
-		return fmt.Sprintf("不明な気圧レベル(%s)", string(p))
-	}
-}
-
-// WeatherEnum は天気コードを表す Enum (値オブジェクト) です。
-type WeatherEnum string
-
-const (
-	Sunny           WeatherEnum = "100" // 晴れ
-	SunnyCloudy     WeatherEnum = "101" // 晴れ時々くもり
-	SunnySometimes  WeatherEnum = "102" // 晴れ一時くもり
-	SunnyRainy      WeatherEnum = "103" // 晴れ時々雨
-	SunnySnowy      WeatherEnum = "105" // 晴れ時々雪
-	CloudySunny     WeatherEnum = "111" // 晴れのちくもり
-	RainySunny      WeatherEnum = "114" // 晴れのち雨
-	SnowySunny      WeatherEnum = "116" // 晴れのち雪
-
-	Cloudy          WeatherEnum = "200" // くもり
-	CloudySunny2    WeatherEnum = "201" // くもり時々晴れ
-	CloudySometimes WeatherEnum = "202" // くもり一時晴れ
-	CloudyRainy     WeatherEnum = "203" // くもり時々雨
-	CloudyRainyTemp WeatherEnum = "204" // くもり一時雨
-	CloudySnowy     WeatherEnum = "205" // くもり時々雪
-	CloudySnowyTemp WeatherEnum = "206" // くもり一時雪
-	CloudyAfterSunny WeatherEnum = "211" // くもりのち晴れ
-	RainyCloudy     WeatherEnum = "214" // くもりのち雨
-	SnowyCloudy     WeatherEnum = "216" // くもりのち雪
-
-	Rain            WeatherEnum = "300" // 雨
-	RainySunny2     WeatherEnum = "301" // 雨時々晴れ
-	RainySometimes  WeatherEnum = "302" // 雨一時晴れ
-	RainyCloudy2    WeatherEnum = "303" // 雨時々くもり
-	RainyCloudyTemp WeatherEnum = "304" // 雨一時くもり
-	RainySnowy      WeatherEnum = "305" // 雨時々雪
-	RainySnowyTemp  WeatherEnum = "306" // 雨一時雪
-	RainyAfterSunny WeatherEnum = "311" // 雨のち晴れ
-	RainyAfterCloudy WeatherEnum = "313" // 雨のちくもり
-	SnowyRainy2     WeatherEnum = "315" // 雨のち雪
-
-	Snow            WeatherEnum = "400" // 雪
-	SnowySunny2     WeatherEnum = "401" // 雪時々晴れ
-	SnowySometimes  WeatherEnum = "402" // 雪一時晴れ
-	SnowyCloudy2    WeatherEnum = "403" // 雪時々くもり
-	SnowyCloudyTemp WeatherEnum = "404" // 雪一時くもり
-	SnowyRainy      WeatherEnum = "405" // 雪時々雨
-	SnowyRainyTemp  WeatherEnum = "406" // 雪一時雨
-	SnowyAfterSunny WeatherEnum = "411" // 雪のち晴れ
-	RainySnowy3     WeatherEnum = "414" // 雪のち雨
-	SnowyAfterCloudy WeatherEnum = "416" // 雪のちくもり
-)
-
-// String は WeatherEnum の文字列表現を返します。
-func (w WeatherEnum) String() string {
-	switch w {
-	case Sunny:
-		return "晴れ"
-	case SunnyCloudy:
-		return "晴れ時々くもり"
-	case SunnySometimes:
-		return "晴れ一時くもり"
-	case SunnyRainy:
-		return "晴れ時々雨"
-	case SunnySnowy:
-		return "晴れ時々雪"
-	case CloudySunny:
-		return "晴れのちくもり"
-	case RainySunny:
-		return "晴れのち雨"
-	case SnowySunny:
-		return "晴れのち雪"
-	case Cloudy:
-		return "くもり"
-	case CloudySunny2:
-		return "くもり時々晴れ"
-	case CloudySometimes:
-		return "くもり一時晴れ"
-	case CloudyRainy:
-		return "くもり時々雨"
-	case CloudyRainyTemp:
-		return "くもり一時雨"
-	case CloudySnowy:
-		return "くもり時々雪"
-	case CloudySnowyTemp:
-		return "くもり一時雪"
-	case CloudyAfterSunny:
-		return "くもりのち晴れ"
-	case RainyCloudy:
-		return "くもりのち雨"
-	case SnowyCloudy:
-		return "くもりのち雪"
-	case Rain:
-		return "雨"
-	case RainySunny2:
-		return "雨時々晴れ"
-	case RainySometimes:
-		return "雨一時晴れ"
-	case RainyCloudy2:
-		return "雨時々くもり"
-	case RainyCloudyTemp:
-		return "雨一時くもり"
-	case RainySnowy:
-		return "雨時々雪"
-	case RainySnowyTemp:
-		return "雨一時雪"
-	case RainyAfterSunny:
-		return "雨のち晴れ"
-	case RainyAfterCloudy:
-		return "雨のちくもり"
-	case SnowyRainy2:
-		return "雨のち雪"
-	case Snow:
-		return "雪"
-	case SnowySunny2:
-		return "雪時々晴れ"
-	case SnowySometimes:
-		return "雪一時晴れ"
-	case SnowyCloudy2:
-		return "雪時々くもり"
-	case SnowyCloudyTemp:
-		return "雪一時くもり"
-	case SnowyRainy:
-		return "雪時々雨"
-	case SnowyRainyTemp:
-		return "雪一時雨"
-	case SnowyAfterSunny:
-		return "雪のち晴れ"
-	case RainySnowy3:
-		return "雪のち雨"
-	case SnowyAfterCloudy:
-		return "雪のちくもり"
+		return i18n.T("厳重警戒")
 	default:
-		return fmt.Sprintf("不明な天気(%s)", string(w))
+		return i18n.Sprintf("不明な気圧レベル(%s)", string(p))
 	}
 }
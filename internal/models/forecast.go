@@ -0,0 +1,215 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// --- Otenki ASP Daily Forecast ---
+
+// Otenki ASP の日別予報で使用するコンテンツID
+const (
+	OtenkiContentWeather       = "day_tenki"      // 天気 (天気コード)
+	OtenkiContentPrecipitation = "day_pre"        // 降水確率 (%)
+	OtenkiContentHighTemp      = "hight_temp"     // 最高気温 (℃)。API のスペルのまま
+	OtenkiContentLowTemp       = "low_temp"       // 最低気温 (℃)
+	OtenkiContentWindSpeed     = "day_wind_v"     // 最大風速 (m/s)
+	OtenkiContentWindDirection = "day_wind_d"     // 最大風速時の風向 (16方位コード)
+	OtenkiContentHeadacheLevel = "zutu_level_day" // 頭痛予報レベル
+	OtenkiContentMinHumidity   = "low_humidity"   // 最小湿度 (%)
+)
+
+// HeadacheLevel は Otenki ASP の頭痛予報レベル (zutu_level_day) を表す Enum (値オブジェクト) です。
+type HeadacheLevel int
+
+const (
+	HeadacheLevelNone    HeadacheLevel = 0 // 心配なし
+	HeadacheLevelSlight  HeadacheLevel = 1 // やや注意
+	HeadacheLevelCaution HeadacheLevel = 2 // 注意
+	HeadacheLevelAlert   HeadacheLevel = 3 // 警戒
+	HeadacheLevelSevere  HeadacheLevel = 4 // 厳重警戒
+)
+
+// String は HeadacheLevel の文字列表現を返します。
+func (h HeadacheLevel) String() string {
+	switch h {
+	case HeadacheLevelNone:
+		return i18n.T("心配なし")
+	case HeadacheLevelSlight:
+		return i18n.T("やや注意")
+	case HeadacheLevelCaution:
+		return i18n.T("注意")
+	case HeadacheLevelAlert:
+		return i18n.T("警戒")
+	case HeadacheLevelSevere:
+		return i18n.T("厳重警戒")
+	default:
+		return i18n.Sprintf("不明な頭痛レベル(%d)", int(h))
+	}
+}
+
+// WindDirection は気象庁の16方位コード (1: 北北東 〜 16: 北、0: 静穏) で風向を表す Enum (値オブジェクト) です。
+type WindDirection int
+
+// WindCalm は風が弱く風向が定まらない (静穏) ことを表します。
+const WindCalm WindDirection = 0
+
+// windDirectionNames は16方位コード (1-16) に対応する方位名です。
+var windDirectionNames = [...]string{
+	"北北東", "北東", "東北東", "東", "東南東", "南東", "南南東", "南",
+	"南南西", "南西", "西南西", "西", "西北西", "北西", "北北西", "北",
+}
+
+// String は WindDirection の文字列表現 (例: "南南西") を返します。
+func (d WindDirection) String() string {
+	switch {
+	case d == WindCalm:
+		return i18n.T("静穏")
+	case d >= 1 && int(d) <= len(windDirectionNames):
+		return i18n.T(windDirectionNames[d-1])
+	default:
+		return i18n.Sprintf("不明な風向(%d)", int(d))
+	}
+}
+
+// Degrees は風が吹いてくる方位を北を 0 度とした時計回りの角度で返します。静穏や不明な値の場合は false を返します。
+func (d WindDirection) Degrees() (float64, bool) {
+	if d < 1 || int(d) > len(windDirectionNames) {
+		return 0, false
+	}
+	return float64(d%16) * 22.5, true
+}
+
+// DailyForecast は Otenki ASP の1日分の予報を型付きで表すエンティティです。
+// API が値を返さなかった項目は nil になります。
+type DailyForecast struct {
+	Date          time.Time      `json:"date"`
+	Weather       WeatherEnum    `json:"weather"`
+	Precipitation *int           `json:"precipitation"`  // 降水確率 (%)
+	HighTemp      *float64       `json:"high_temp"`      // 最高気温 (℃)
+	LowTemp       *float64       `json:"low_temp"`       // 最低気温 (℃)
+	WindSpeed     *float64       `json:"wind_speed"`     // 最大風速 (m/s)
+	WindDirection *WindDirection `json:"wind_direction"` // 最大風速時の風向
+	HeadacheLevel *HeadacheLevel `json:"headache_level"` // 頭痛予報レベル
+	MinHumidity   *int           `json:"min_humidity"`   // 最小湿度 (%)
+}
+
+// DailyForecasts は Elements を日付ごとにまとめた DailyForecast のリストを日付の昇順で返します。
+// 未知のコンテンツIDは無視します。値の型が想定と異なる場合はエラーを返します。
+func (g *GetOtenkiASPResponse) DailyForecasts() ([]DailyForecast, error) {
+	byDate := make(map[time.Time]*DailyForecast)
+	forecastFor := func(date time.Time) *DailyForecast {
+		f, ok := byDate[date]
+		if !ok {
+			f = &DailyForecast{Date: date}
+			byDate[date] = f
+		}
+		return f
+	}
+
+	for _, elem := range g.Elements {
+		for date, value := range elem.Records {
+			if value == nil {
+				continue
+			}
+			f := forecastFor(date)
+			if err := f.set(elem.ContentID, value); err != nil {
+				return nil, i18n.Errorf("%s (%s) の値 %v を変換できません: %w", elem.ContentID, date.Format("2006-01-02"), value, err)
+			}
+		}
+	}
+
+	forecasts := make([]DailyForecast, 0, len(byDate))
+	for _, f := range byDate {
+		forecasts = append(forecasts, *f)
+	}
+	sort.Slice(forecasts, func(i, j int) bool {
+		return forecasts[i].Date.Before(forecasts[j].Date)
+	})
+	return forecasts, nil
+}
+
+// set はコンテンツIDに対応するフィールドに value を変換して設定します。
+func (f *DailyForecast) set(contentID string, value interface{}) error {
+	switch contentID {
+	case OtenkiContentWeather:
+		n, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		f.Weather = WeatherEnum(strconv.Itoa(n))
+	case OtenkiContentPrecipitation:
+		return setInt(&f.Precipitation, value)
+	case OtenkiContentHighTemp:
+		return setFloat(&f.HighTemp, value)
+	case OtenkiContentLowTemp:
+		return setFloat(&f.LowTemp, value)
+	case OtenkiContentWindSpeed:
+		return setFloat(&f.WindSpeed, value)
+	case OtenkiContentWindDirection:
+		n, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		d := WindDirection(n)
+		f.WindDirection = &d
+	case OtenkiContentHeadacheLevel:
+		n, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		h := HeadacheLevel(n)
+		f.HeadacheLevel = &h
+	case OtenkiContentMinHumidity:
+		return setInt(&f.MinHumidity, value)
+	}
+	return nil
+}
+
+func setInt(dst **int, value interface{}) error {
+	n, err := toInt(value)
+	if err != nil {
+		return err
+	}
+	*dst = &n
+	return nil
+}
+
+func setFloat(dst **float64, value interface{}) error {
+	v, err := toFloat(value)
+	if err != nil {
+		return err
+	}
+	*dst = &v
+	return nil
+}
+
+// toFloat は JSON からデコードされた値 (数値または数値の文字列) を float64 に変換します。
+func toFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, i18n.Errorf("予期しない型 %T です", value)
+	}
+}
+
+// toInt は JSON からデコードされた値を整数に変換します。小数部を持つ値はエラーになります。
+func toInt(value interface{}) (int, error) {
+	v, err := toFloat(value)
+	if err != nil {
+		return 0, err
+	}
+	if v != float64(int(v)) {
+		return 0, i18n.Errorf("整数ではありません")
+	}
+	return int(v), nil
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyForecasts(t *testing.T) {
+	day1 := time.Date(2025, 5, 20, 0, 0, 0, 0, JST)
+	day2 := day1.AddDate(0, 0, 1)
+	res := GetOtenkiASPResponse{
+		Status: "OK",
+		Elements: []Element{
+			{ContentID: OtenkiContentWeather, Records: map[time.Time]interface{}{day2: "300", day1: "100"}},
+			{ContentID: OtenkiContentPrecipitation, Records: map[time.Time]interface{}{day1: 10.0, day2: 80.0}},
+			{ContentID: OtenkiContentHighTemp, Records: map[time.Time]interface{}{day1: 24.5}},
+			{ContentID: OtenkiContentLowTemp, Records: map[time.Time]interface{}{day1: "15.2"}},
+			{ContentID: OtenkiContentWindSpeed, Records: map[time.Time]interface{}{day1: 3.2}},
+			{ContentID: OtenkiContentWindDirection, Records: map[time.Time]interface{}{day1: 9.0}},
+			{ContentID: OtenkiContentHeadacheLevel, Records: map[time.Time]interface{}{day1: 3.0}},
+			{ContentID: OtenkiContentMinHumidity, Records: map[time.Time]interface{}{day1: 45.0}},
+			{ContentID: "unknown_content", Records: map[time.Time]interface{}{day1: []interface{}{}}},
+		},
+	}
+
+	forecasts, err := res.DailyForecasts()
+	require.NoError(t, err)
+	require.Len(t, forecasts, 2)
+
+	f := forecasts[0]
+	assert.Equal(t, day1, f.Date, "日付の昇順に並ぶはずです")
+	assert.Equal(t, Sunny, f.Weather)
+	assert.Equal(t, 10, *f.Precipitation)
+	assert.InDelta(t, 24.5, *f.HighTemp, 1e-9)
+	assert.InDelta(t, 15.2, *f.LowTemp, 1e-9, "数値の文字列も変換されるはずです")
+	assert.InDelta(t, 3.2, *f.WindSpeed, 1e-9)
+	assert.Equal(t, "南南西", f.WindDirection.String())
+	assert.Equal(t, HeadacheLevelAlert, *f.HeadacheLevel)
+	assert.Equal(t, 45, *f.MinHumidity)
+
+	assert.Equal(t, Rain, forecasts[1].Weather)
+	assert.Nil(t, forecasts[1].HighTemp, "値が無い項目は nil のはずです")
+}
+
+func TestDailyForecastsInvalidValue(t *testing.T) {
+	day := time.Date(2025, 5, 20, 0, 0, 0, 0, JST)
+	res := GetOtenkiASPResponse{Elements: []Element{
+		{ContentID: OtenkiContentPrecipitation, Records: map[time.Time]interface{}{day: "abc"}},
+	}}
+	_, err := res.DailyForecasts()
+	assert.Error(t, err)
+}
+
+func TestWindDirection(t *testing.T) {
+	assert.Equal(t, "静穏", WindCalm.String())
+	assert.Equal(t, "北北東", WindDirection(1).String())
+	assert.Equal(t, "北", WindDirection(16).String())
+	assert.Contains(t, WindDirection(17).String(), "不明")
+
+	deg, ok := WindDirection(16).Degrees()
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, deg)
+	deg, _ = WindDirection(8).Degrees()
+	assert.Equal(t, 180.0, deg)
+	_, ok = WindCalm.Degrees()
+	assert.False(t, ok)
+}
+
+func TestHeadacheLevelString(t *testing.T) {
+	assert.Equal(t, "心配なし", HeadacheLevelNone.String())
+	assert.Equal(t, "厳重警戒", HeadacheLevelSevere.String())
+	assert.Contains(t, HeadacheLevel(9).String(), "不明")
+}
@@ -1,11 +1,17 @@
 package models
 
 import (
-	"fmt"
+	"encoding/json"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
 )
 
+// JST は API の日時が表すタイムゾーン (日本標準時) です。
+var JST = time.FixedZone("JST", 9*60*60)
+
 // APIDateTime は API からの特定の日時フォーマット "YYYY-MM-DD HH" を処理するためのカスタム型 (値オブジェクト) です。
 type APIDateTime struct {
 	time.Time
@@ -28,7 +34,7 @@ func (adt *APIDateTime) UnmarshalJSON(b []byte) error {
 		// (一部のAPIレスポンスで必要になる可能性があるため)
 		t, errDate := time.Parse("2006-01-02", s)
 		if errDate != nil {
-			return fmt.Errorf("APIDateTime %q のパースに失敗しました: %w", s, err)
+			return i18n.Errorf("APIDateTime %q のパースに失敗しました: %w", s, err)
 		}
 		adt.Time = t
 		return nil
@@ -46,6 +52,122 @@ func (adt APIDateTime) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + adt.Time.Format(apiDateTimeLayout) + `"`), nil
 }
 
+// numericString は文字列 ("15.3") と数値 (15.3) のどちらの JSON 表現も受け付ける文字列です。
+type numericString string
+
+// UnmarshalJSON は numericString の json.Unmarshaler インターフェースを実装します。
+func (n *numericString) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" {
+		s = ""
+	}
+	*n = numericString(s)
+	return nil
+}
+
+// weatherStatusByTimeJSON は API の JSON 表現 (全ての数値が文字列) に対応する WeatherStatusByTime の中間表現です。
+type weatherStatusByTimeJSON struct {
+	Time          numericString     `json:"time"`
+	Weather       WeatherEnum       `json:"weather"`
+	Temp          *numericString    `json:"temp"`
+	Pressure      numericString     `json:"pressure"`
+	PressureLevel PressureLevelEnum `json:"pressure_level"`
+}
+
+// UnmarshalJSON は WeatherStatusByTime の json.Unmarshaler インターフェースを実装します。
+// 文字列の時刻・気温・気圧を数値にパースします。気温が null または空文字列の場合は nil になります。
+// 気温・気圧の元の文字列は MarshalJSON でそのまま出力するために保持します。
+func (w *WeatherStatusByTime) UnmarshalJSON(b []byte) error {
+	var raw weatherStatusByTimeJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	hour, err := strconv.Atoi(string(raw.Time))
+	if err != nil {
+		return i18n.Errorf("時刻 %q のパースに失敗しました: %w", raw.Time, err)
+	}
+	pressure, err := strconv.ParseFloat(string(raw.Pressure), 64)
+	if err != nil {
+		return i18n.Errorf("気圧 %q のパースに失敗しました: %w", raw.Pressure, err)
+	}
+	var temp *float64
+	if raw.Temp != nil && *raw.Temp != "" {
+		t, err := strconv.ParseFloat(string(*raw.Temp), 64)
+		if err != nil {
+			return i18n.Errorf("気温 %q のパースに失敗しました: %w", *raw.Temp, err)
+		}
+		temp = &t
+	}
+
+	*w = WeatherStatusByTime{
+		Hour:          hour,
+		Weather:       raw.Weather,
+		Temp:          temp,
+		Pressure:      pressure,
+		PressureLevel: raw.PressureLevel,
+		rawPressure:   string(raw.Pressure),
+	}
+	if temp != nil {
+		w.rawTemp = string(*raw.Temp)
+	}
+	return nil
+}
+
+// MarshalJSON は WeatherStatusByTime の json.Marshaler インターフェースを実装します。
+// API と同じく数値を文字列で出力します。API から読み込んだ気温・気圧は元の文字列をそのまま出力します (例: "1010.0" → "1010.0")。
+// 値が変更された場合やプログラムで組み立てた場合は、丸めずに値を表せる最短の桁数で出力します (例: 1007.25 → "1007.25")。At は出力しません。
+func (w WeatherStatusByTime) MarshalJSON() ([]byte, error) {
+	raw := weatherStatusByTimeJSON{
+		Time:          numericString(strconv.Itoa(w.Hour)),
+		Weather:       w.Weather,
+		Pressure:      formatNumericString(w.Pressure, w.rawPressure),
+		PressureLevel: w.PressureLevel,
+	}
+	if w.Temp != nil {
+		temp := formatNumericString(*w.Temp, w.rawTemp)
+		raw.Temp = &temp
+	}
+	return json.Marshal(raw)
+}
+
+// formatNumericString は v を表す numericString を返します。
+// 元の文字列 orig が v を表している場合は orig をそのまま返し、それ以外は丸めずに最短の桁数で書式化します。
+func formatNumericString(v float64, orig string) numericString {
+	if orig != "" {
+		if parsed, err := strconv.ParseFloat(orig, 64); err == nil && parsed == v {
+			return numericString(orig)
+		}
+	}
+	return numericString(strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+// UnmarshalJSON は GetWeatherStatusResponse の json.Unmarshaler インターフェースを実装します。
+// 各時間別データの At を、DateTime の日付と日の区分 (昨日〜明後日) および時刻から日本時間で計算します。
+func (g *GetWeatherStatusResponse) UnmarshalJSON(b []byte) error {
+	type plain GetWeatherStatusResponse // UnmarshalJSON の再帰呼び出しを避けるための別名
+	if err := json.Unmarshal(b, (*plain)(g)); err != nil {
+		return err
+	}
+	if g.DateTime.IsZero() {
+		return nil
+	}
+	year, month, day := g.DateTime.Date()
+	for offset := -1; offset <= 2; offset++ {
+		dayData, _ := g.ByDayOffset(offset)
+		for i := range dayData {
+			dayData[i].At = time.Date(year, month, day+offset, dayData[i].Hour, 0, 0, 0, JST)
+		}
+	}
+	return nil
+}
+
+// NewFloat64 は float64 へのポインタを返すヘルパー関数です。
+// WeatherStatusByTime.Temp などのオプショナルな数値フィールドで役立ちます。
+func NewFloat64(f float64) *float64 {
+	return &f
+}
+
 // NewString は文字列へのポインタを返すヘルパー関数です。
 // JSONのオプショナルな文字列フィールドなどで役立ちます。
 func NewString(s string) *string {
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeatherStatusByTimeUnmarshalJSON(t *testing.T) {
+	var w WeatherStatusByTime
+	require.NoError(t, json.Unmarshal([]byte(`{"time":"13","weather":"100","temp":"15.3","pressure":"1007.5","pressure_level":"2"}`), &w))
+	assert.Equal(t, 13, w.Hour)
+	assert.Equal(t, Sunny, w.Weather)
+	require.NotNil(t, w.Temp)
+	assert.InDelta(t, 15.3, *w.Temp, 1e-9)
+	assert.InDelta(t, 1007.5, w.Pressure, 1e-9)
+	assert.Equal(t, SlightAlert, w.PressureLevel)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"time":0,"weather":"200","temp":null,"pressure":1010,"pressure_level":"0"}`), &w))
+	assert.Equal(t, 0, w.Hour)
+	assert.Nil(t, w.Temp, "null の気温は nil になるはずです")
+	assert.InDelta(t, 1010.0, w.Pressure, 1e-9, "数値の気圧も受け付けるはずです")
+
+	assert.Error(t, json.Unmarshal([]byte(`{"time":"0","pressure":"abc"}`), &w), "数値でない気圧はエラーになるはずです")
+	assert.Error(t, json.Unmarshal([]byte(`{"time":"","pressure":"1010"}`), &w), "空の時刻はエラーになるはずです")
+}
+
+func TestWeatherStatusByTimeMarshalJSON(t *testing.T) {
+	w := WeatherStatusByTime{Hour: 3, Weather: Cloudy, Temp: NewFloat64(15), Pressure: 1007.25, PressureLevel: Normal}
+	b, err := json.Marshal(w)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"time":"3","weather":"200","temp":"15","pressure":"1007.25","pressure_level":"0"}`, string(b),
+		"API と同じく文字列で、値を丸めずに出力されるはずです")
+
+	w.Temp = nil
+	b, err = json.Marshal(w)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"time":"3","weather":"200","temp":null,"pressure":"1007.25","pressure_level":"0"}`, string(b))
+
+	var decoded WeatherStatusByTime
+	require.NoError(t, json.Unmarshal([]byte(`{"time":"13","weather":"100","temp":"15.3","pressure":"1007.5","pressure_level":"2"}`), &decoded))
+	b, err = json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"time":"13","weather":"100","temp":"15.3","pressure":"1007.5","pressure_level":"2"}`, string(b), "API の値はそのまま出力されるはずです")
+
+	decoded.Pressure = 1008
+	b, err = json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"time":"13","weather":"100","temp":"15.3","pressure":"1008","pressure_level":"2"}`, string(b), "変更された値は新しい値で出力されるはずです")
+}
+
+func TestWeatherStatusByTimeMarshalJSONRoundTrip(t *testing.T) {
+	input := `{"time":"0","weather":"100","temp":"15.0","pressure":"1010.0","pressure_level":"0"}`
+	var w WeatherStatusByTime
+	require.NoError(t, json.Unmarshal([]byte(input), &w))
+	b, err := json.Marshal(w)
+	require.NoError(t, err)
+	assert.Equal(t, input, string(b), "API の文字列がバイト単位でそのまま出力されるはずです")
+}
+
+func TestGetWeatherStatusResponseAt(t *testing.T) {
+	body := `{
+		"place_name": "渋谷区", "place_id": "113", "prefectures_id": "13", "dateTime": "2025-05-20 11",
+		"yesterday": [{"time":"23","weather":"100","temp":"15.0","pressure":"1010.0","pressure_level":"0"}],
+		"today": [{"time":"0","weather":"100","temp":"15.0","pressure":"1010.0","pressure_level":"0"}],
+		"tomorrow": [],
+		"dayaftertomorrow": [{"time":"5","weather":"100","temp":null,"pressure":"1010.0","pressure_level":"0"}]
+	}`
+	var res GetWeatherStatusResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+
+	assert.Equal(t, time.Date(2025, 5, 19, 23, 0, 0, 0, JST), res.Yesterday[0].At)
+	assert.Equal(t, time.Date(2025, 5, 20, 0, 0, 0, 0, JST), res.Today[0].At)
+	assert.Equal(t, time.Date(2025, 5, 22, 5, 0, 0, 0, JST), res.DayAfterTomorrow[0].At)
+	assert.Equal(t, "渋谷区", res.PlaceName)
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// OtenkiValueType は Otenki ASP のコンテンツが返す値の型です。
+type OtenkiValueType int
+
+const (
+	OtenkiValueNumber        OtenkiValueType = iota // 小数 (例: 気温)
+	OtenkiValueInteger                              // 整数 (例: 降水確率)
+	OtenkiValueWeatherCode                          // 天気コード (WeatherEnum)
+	OtenkiValueWindDirection                        // 16方位コード (WindDirection)
+	OtenkiValueHeadacheLevel                        // 頭痛予報レベル (HeadacheLevel)
+)
+
+// OtenkiContent は Otenki ASP のコンテンツID (contents_id) の説明です。
+type OtenkiContent struct {
+	ID        string          // コンテンツID (例: "day_pre")
+	Title     string          // 表示名 (例: "降水確率")
+	Unit      string          // 単位 (例: "%")。単位が無い場合は空文字列
+	ValueType OtenkiValueType // 値の型
+}
+
+// OtenkiContents は既知のコンテンツIDの一覧です。並び順はデフォルトで取得・表示する順序です。
+var OtenkiContents = []OtenkiContent{
+	{ID: OtenkiContentWeather, Title: "天気", ValueType: OtenkiValueWeatherCode},
+	{ID: OtenkiContentPrecipitation, Title: "降水確率", Unit: "%", ValueType: OtenkiValueInteger},
+	{ID: OtenkiContentHighTemp, Title: "最高気温", Unit: "℃", ValueType: OtenkiValueNumber},
+	{ID: OtenkiContentLowTemp, Title: "最低気温", Unit: "℃", ValueType: OtenkiValueNumber},
+	{ID: OtenkiContentWindSpeed, Title: "最大風速", Unit: "m/s", ValueType: OtenkiValueNumber},
+	{ID: OtenkiContentWindDirection, Title: "最大風速時風向", ValueType: OtenkiValueWindDirection},
+	{ID: OtenkiContentHeadacheLevel, Title: "気圧予報レベル", ValueType: OtenkiValueHeadacheLevel},
+	{ID: OtenkiContentMinHumidity, Title: "最小湿度", Unit: "%", ValueType: OtenkiValueInteger},
+}
+
+// DefaultOtenkiContentIDs はデフォルトで取得するコンテンツIDの一覧を返します。
+func DefaultOtenkiContentIDs() []string {
+	ids := make([]string, len(OtenkiContents))
+	for i, c := range OtenkiContents {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+// LookupOtenkiContent はコンテンツIDに対応する OtenkiContent を返します。
+// 未知のコンテンツIDの場合は、ID を表示名とする小数型の OtenkiContent と false を返します。
+func LookupOtenkiContent(id string) (OtenkiContent, bool) {
+	for _, c := range OtenkiContents {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return OtenkiContent{ID: id, Title: id, ValueType: OtenkiValueNumber}, false
+}
+
+// Header は現在の言語に翻訳した単位付きの表示名 (例: "降水確率(%)") を返します。
+func (c OtenkiContent) Header() string {
+	title := i18n.T(c.Title)
+	if c.Unit == "" {
+		return title
+	}
+	return fmt.Sprintf("%s(%s)", title, c.Unit)
+}
+
+// FormatValue は Records の値をテーブル表示用の文字列に整形します。
+// 天気コードは絵文字に、整数型の値は小数点なしに、小数型の値は小数点以下1桁に整形します。
+func (c OtenkiContent) FormatValue(value interface{}) string {
+	switch c.ValueType {
+	case OtenkiValueWeatherCode:
+		code, err := toInt(value)
+		if err != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		if emoji := WeatherEnum(strconv.Itoa(code)).Emoji(); emoji != "" {
+			return emoji
+		}
+		return strconv.Itoa(code)
+	case OtenkiValueInteger, OtenkiValueWindDirection, OtenkiValueHeadacheLevel:
+		if n, err := toInt(value); err == nil {
+			return strconv.Itoa(n)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%.1f", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
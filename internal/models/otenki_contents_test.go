@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupOtenkiContent(t *testing.T) {
+	content, ok := LookupOtenkiContent(OtenkiContentPrecipitation)
+	assert.True(t, ok)
+	assert.Equal(t, "降水確率(%)", content.Header())
+
+	content, ok = LookupOtenkiContent(OtenkiContentWeather)
+	assert.True(t, ok)
+	assert.Equal(t, "天気", content.Header(), "単位が無い場合は表示名のみのはずです")
+
+	content, ok = LookupOtenkiContent("unknown_content")
+	assert.False(t, ok)
+	assert.Equal(t, "unknown_content", content.Header(), "未知のIDはIDを表示名にするはずです")
+}
+
+func TestDefaultOtenkiContentIDs(t *testing.T) {
+	ids := DefaultOtenkiContentIDs()
+	assert.Len(t, ids, len(OtenkiContents))
+	assert.Equal(t, OtenkiContentWeather, ids[0])
+
+	ids[0] = "changed"
+	assert.Equal(t, OtenkiContentWeather, OtenkiContents[0].ID, "返されたスライスの変更はレジストリに影響しないはずです")
+}
+
+func TestOtenkiContentFormatValue(t *testing.T) {
+	tests := []struct {
+		id       string
+		value    interface{}
+		expected string
+	}{
+		{OtenkiContentWeather, "100", "☀"},
+		{OtenkiContentWeather, "101", "🌤"},
+		{OtenkiContentWeather, "313", "☔"},
+		{OtenkiContentWeather, 999.0, "999"},
+		{OtenkiContentPrecipitation, 30.0, "30"},
+		{OtenkiContentHighTemp, 24.46, "24.5"},
+		{OtenkiContentHighTemp, "24.5", "24.5"},
+		{OtenkiContentHeadacheLevel, "2", "2"},
+		{OtenkiContentMinHumidity, "abc", "abc"},
+		{"unknown_content", 1.25, "1.2"},
+	}
+	for _, tt := range tests {
+		content, _ := LookupOtenkiContent(tt.id)
+		assert.Equal(t, tt.expected, content.FormatValue(tt.value), "%s: %v", tt.id, tt.value)
+	}
+}
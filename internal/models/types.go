@@ -1,9 +1,10 @@
 package models
 
 import (
-	"fmt"
 	"regexp"
 	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
 )
 
 // --- Weather Point API Structures ---
@@ -19,11 +20,11 @@ type WeatherPoint struct {
 func (w *WeatherPoint) Validate() error {
 	// 注: 元の正規表現 `^\\d{5}$` はGoでは `^\d{5}$` が正しいようです。
 	if matched, _ := regexp.MatchString(`^\d{5}$`, w.CityCode); !matched {
-		return fmt.Errorf("CityCode は5桁の数字である必要があります、取得値: %s", w.CityCode)
+		return i18n.Errorf("CityCode は5桁の数字である必要があります、取得値: %s", w.CityCode)
 	}
 	// 注: 元の正規表現 `^[\\uff61-\\uff9f]+$` はGoでは `^[\uff61-\uff9f]+$` が正しいようです。
 	if matched, _ := regexp.MatchString(`^[\uff61-\uff9f]+$`, w.NameKata); !matched {
-		return fmt.Errorf("NameKata は半角カタカナである必要があります、取得値: %s", w.NameKata)
+		return i18n.Errorf("NameKata は半角カタカナである必要があります、取得値: %s", w.NameKata)
 	}
 	return nil
 }
@@ -55,16 +56,16 @@ type GetPainStatus struct {
 // Validate は GetPainStatus の割合フィールドが有効 (非負) かどうかを検証します。
 func (g *GetPainStatus) Validate() error {
 	if g.RateNormal < 0 {
-		return fmt.Errorf("RateNormal は非負である必要があります、取得値: %f", g.RateNormal)
+		return i18n.Errorf("RateNormal は非負である必要があります、取得値: %f", g.RateNormal)
 	}
 	if g.RateLittle < 0 {
-		return fmt.Errorf("RateLittle は非負である必要があります、取得値: %f", g.RateLittle)
+		return i18n.Errorf("RateLittle は非負である必要があります、取得値: %f", g.RateLittle)
 	}
 	if g.RatePainful < 0 {
-		return fmt.Errorf("RatePainful は非負である必要があります、取得値: %f", g.RatePainful)
+		return i18n.Errorf("RatePainful は非負である必要があります、取得値: %f", g.RatePainful)
 	}
 	if g.RateBad < 0 {
-		return fmt.Errorf("RateBad は非負である必要があります、取得値: %f", g.RateBad)
+		return i18n.Errorf("RateBad は非負である必要があります、取得値: %f", g.RateBad)
 	}
 	return nil
 }
@@ -77,18 +78,25 @@ type GetPainStatusResponse struct {
 // --- Weather Status API Structures ---
 
 // WeatherStatusByTime は特定の時刻における気象状況を表すエンティティです。
+// API は数値を文字列で返しますが、アンマーシャル時にパースした値を保持します。
+// JSON へのマーシャル時は API と同じく文字列で出力し、API から読み込んだ値は元の文字列のまま出力します (models.go を参照)。
 type WeatherStatusByTime struct {
-	Time          string            `json:"time"`           // 例: "0" - JSONに合わせるため int から string に変更
-	Weather       WeatherEnum       `json:"weather"`
-	Temp          *string           `json:"temp"`           // 例: "15.3" - JSONに合わせるため *float64 から *string に変更 (nullの場合あり)
-	Pressure      string            `json:"pressure"`       // 例: "1007.5" - JSONに合わせるため float64 から string に変更
-	PressureLevel PressureLevelEnum `json:"pressure_level"`
+	Hour          int               // 時 (0-23)。JSON では "time" (例: "0")
+	Weather       WeatherEnum       // JSON では "weather"
+	Temp          *float64          // 気温 (℃)。JSON では "temp" (例: "15.3")。null の場合は nil
+	Pressure      float64           // 気圧 (hPa)。JSON では "pressure" (例: "1007.5")
+	PressureLevel PressureLevelEnum // JSON では "pressure_level"
+	At            time.Time         // 日本時間の絶対時刻。GetWeatherStatusResponse のアンマーシャル時に DateTime と日の区分から計算される (JSON には出力しない)
+
+	rawTemp     string // アンマーシャル時の気温の元の文字列 (例: "15.0")
+	rawPressure string // アンマーシャル時の気圧の元の文字列 (例: "1010.0")
 }
 
 // Validate は WeatherStatusByTime のフィールドが有効かどうかを検証します。
-// 注: Time の型が string に変更されたため、時刻の検証は削除されました。必要に応じてパース/検証を追加してください。
 func (w *WeatherStatusByTime) Validate() error {
-	// 必要であれば検証ロジックを追加 (例: Time が 0-23 の整数にパースできるかチェック)
+	if w.Hour < 0 || w.Hour > 23 {
+		return i18n.Errorf("Hour は 0 から 23 である必要があります、取得値: %d", w.Hour)
+	}
 	return nil
 }
 
@@ -104,11 +112,46 @@ type GetWeatherStatusResponse struct {
 	DayAfterTomorrow []WeatherStatusByTime `json:"dayaftertomorrow"`
 }
 
+// WeatherStatusDayName は日付オフセット (-1: 昨日 〜 2: 明後日) に対応する日の名前 (JSON キー名) を返します。
+// 範囲外のオフセットの場合は false を返します。
+func WeatherStatusDayName(dayOffset int) (string, bool) {
+	switch dayOffset {
+	case -1:
+		return "yesterday", true
+	case 0:
+		return "today", true
+	case 1:
+		return "tomorrow", true
+	case 2:
+		return "dayaftertomorrow", true
+	default:
+		return "", false
+	}
+}
+
+// ByDayOffset は日付オフセット (-1: 昨日 〜 2: 明後日) に対応する時間別データを返します。
+// 1回のレスポンスに昨日から明後日までの全データが含まれるため、複数日の表示でもAPI呼び出しは1回で済みます。
+// 範囲外のオフセットの場合は false を返します。
+func (g *GetWeatherStatusResponse) ByDayOffset(dayOffset int) ([]WeatherStatusByTime, bool) {
+	switch dayOffset {
+	case -1:
+		return g.Yesterday, true
+	case 0:
+		return g.Today, true
+	case 1:
+		return g.Tomorrow, true
+	case 2:
+		return g.DayAfterTomorrow, true
+	default:
+		return nil, false
+	}
+}
+
 // Validate は GetWeatherStatusResponse の PlaceID フィールドが有効かどうかを検証します。
 func (g *GetWeatherStatusResponse) Validate() error {
 	// 注: 元の正規表現 `^\\d{3}$` はGoでは `^\d{3}$` が正しいようです。
 	if matched, _ := regexp.MatchString(`^\d{3}$`, g.PlaceID); !matched {
-		return fmt.Errorf("PlaceID は3桁の数字である必要があります、取得値: %s", g.PlaceID)
+		return i18n.Errorf("PlaceID は3桁の数字である必要があります、取得値: %s", g.PlaceID)
 	}
 	return nil
 }
@@ -0,0 +1,382 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// WeatherEnum は天気コードを表す Enum (値オブジェクト) です。
+// コードは気象庁の天気予報の天気コード (例: "101" は晴れ時々くもり) で、各コードの属性は weatherCatalog に定義します。
+type WeatherEnum string
+
+// 基本の天気コードです。それ以外のコードは weatherCatalog を参照してください。
+const (
+	Sunny  WeatherEnum = "100" // 晴れ
+	Cloudy WeatherEnum = "200" // くもり
+	Rain   WeatherEnum = "300" // 雨
+	Snow   WeatherEnum = "400" // 雪
+)
+
+// WeatherCondition は天気コードを構成する天気の種類です。
+type WeatherCondition int
+
+const (
+	ConditionNone         WeatherCondition = iota // 無し
+	ConditionSunny                                // 晴れ
+	ConditionCloudy                               // くもり
+	ConditionRain                                 // 雨
+	ConditionSnow                                 // 雪
+	ConditionSleet                                // 雨か雪、みぞれ
+	ConditionThunderstorm                         // 雷雨
+	ConditionFog                                  // 霧
+)
+
+// String は WeatherCondition の文字列表現を返します。
+func (c WeatherCondition) String() string {
+	switch c {
+	case ConditionSunny:
+		return i18n.T("晴れ")
+	case ConditionCloudy:
+		return i18n.T("くもり")
+	case ConditionRain:
+		return i18n.T("雨")
+	case ConditionSnow:
+		return i18n.T("雪")
+	case ConditionSleet:
+		return i18n.T("みぞれ")
+	case ConditionThunderstorm:
+		return i18n.T("雷雨")
+	case ConditionFog:
+		return i18n.T("霧")
+	default:
+		return ""
+	}
+}
+
+// Precipitation は雨や雪が降る天気かどうかを返します。
+func (c WeatherCondition) Precipitation() bool {
+	switch c {
+	case ConditionRain, ConditionSnow, ConditionSleet, ConditionThunderstorm:
+		return true
+	default:
+		return false
+	}
+}
+
+// WeatherTransition は主な天気から副次的な天気への変わり方です。
+type WeatherTransition int
+
+const (
+	TransitionNone        WeatherTransition = iota // 無し (副次的な天気を伴う場合を含む)
+	TransitionSometimes                            // 時々
+	TransitionTemporarily                          // 一時
+	TransitionLater                                // のち
+)
+
+// String は WeatherTransition の文字列表現を返します。
+func (t WeatherTransition) String() string {
+	switch t {
+	case TransitionSometimes:
+		return i18n.T("時々")
+	case TransitionTemporarily:
+		return i18n.T("一時")
+	case TransitionLater:
+		return i18n.T("のち")
+	default:
+		return ""
+	}
+}
+
+// WeatherInfo は天気コードの属性です。
+type WeatherInfo struct {
+	Name          string            // 日本語の名前 (例: "晴れ時々くもり")。表示には翻訳した WeatherEnum.String() を使う
+	Primary       WeatherCondition  // 主な天気 (例: 晴れ)
+	Secondary     WeatherCondition  // 副次的な天気 (例: くもり)。無い場合は ConditionNone
+	Transition    WeatherTransition // 主な天気から副次的な天気への変わり方 (例: 時々)
+	Precipitation bool              // 雨や雪が降る可能性があるかどうか
+	Emoji         string            // 絵文字のアイコン
+	NerdIcon      string            // Nerd Font (Weather Icons) のアイコン
+}
+
+// newWeatherInfo は天気コードの属性を作成します。降水の有無とアイコンは主な天気と副次的な天気から決めます。
+func newWeatherInfo(name string, primary WeatherCondition, transition WeatherTransition, secondary WeatherCondition) WeatherInfo {
+	return WeatherInfo{
+		Name:          name,
+		Primary:       primary,
+		Secondary:     secondary,
+		Transition:    transition,
+		Precipitation: primary.Precipitation() || secondary.Precipitation(),
+		Emoji:         weatherEmoji(primary, secondary),
+		NerdIcon:      weatherNerdIcon(primary, secondary),
+	}
+}
+
+// weatherEmoji は主な天気と副次的な天気から絵文字を選びます。
+func weatherEmoji(primary, secondary WeatherCondition) string {
+	if primary == ConditionThunderstorm || secondary == ConditionThunderstorm {
+		return "⛈"
+	}
+	switch primary {
+	case ConditionSunny:
+		switch secondary {
+		case ConditionCloudy:
+			return "🌤"
+		case ConditionRain, ConditionSleet:
+			return "🌦"
+		case ConditionSnow:
+			return "🌨"
+		}
+		return "☀"
+	case ConditionCloudy:
+		switch secondary {
+		case ConditionSunny:
+			return "🌥"
+		case ConditionRain:
+			return "🌧"
+		case ConditionSnow, ConditionSleet:
+			return "🌨"
+		case ConditionFog:
+			return "🌫"
+		}
+		return "☁"
+	case ConditionRain:
+		return "☔"
+	case ConditionSnow, ConditionSleet:
+		return "🌨"
+	case ConditionFog:
+		return "🌫"
+	default:
+		return ""
+	}
+}
+
+// Nerd Font の Weather Icons のアイコンです。
+const (
+	nerdDaySunny         = "\ue30d" // nf-weather-day_sunny
+	nerdDayCloudy        = "\ue302" // nf-weather-day_cloudy
+	nerdDayRain          = "\ue308" // nf-weather-day_rain
+	nerdDaySnow          = "\ue30a" // nf-weather-day_snow
+	nerdDayThunderstorm  = "\ue30f" // nf-weather-day_thunderstorm
+	nerdDayFog           = "\ue303" // nf-weather-day_fog
+	nerdDaySunnyOvercast = "\ue30c" // nf-weather-day_sunny_overcast
+	nerdCloudy           = "\ue312" // nf-weather-cloudy
+	nerdRain             = "\ue318" // nf-weather-rain
+	nerdSnow             = "\ue31a" // nf-weather-snow
+	nerdSleet            = "\ue3ad" // nf-weather-sleet
+	nerdThunderstorm     = "\ue31d" // nf-weather-thunderstorm
+	nerdFog              = "\ue313" // nf-weather-fog
+)
+
+// weatherNerdIcon は主な天気と副次的な天気から Nerd Font のアイコンを選びます。
+// 晴れを伴う天気には昼の (太陽の付いた) アイコンを使います。
+func weatherNerdIcon(primary, secondary WeatherCondition) string {
+	if primary == ConditionSunny {
+		switch secondary {
+		case ConditionCloudy:
+			return nerdDayCloudy
+		case ConditionRain:
+			return nerdDayRain
+		case ConditionSnow, ConditionSleet:
+			return nerdDaySnow
+		case ConditionThunderstorm:
+			return nerdDayThunderstorm
+		case ConditionFog:
+			return nerdDayFog
+		}
+		return nerdDaySunny
+	}
+	if primary == ConditionCloudy && secondary == ConditionSunny {
+		return nerdDaySunnyOvercast
+	}
+	if primary == ConditionCloudy && secondary != ConditionNone || secondary == ConditionThunderstorm {
+		// くもりの場合と雷を伴う場合は副次的な天気のアイコンを使う
+		primary = secondary
+	}
+	switch primary {
+	case ConditionCloudy:
+		return nerdCloudy
+	case ConditionRain:
+		return nerdRain
+	case ConditionSnow:
+		return nerdSnow
+	case ConditionSleet:
+		return nerdSleet
+	case ConditionThunderstorm:
+		return nerdThunderstorm
+	case ConditionFog:
+		return nerdFog
+	default:
+		return ""
+	}
+}
+
+// weatherCatalog は気象庁の天気予報の天気コードとその属性です。
+// 「朝の内」「山沿い」などの時間帯や地域の限定は名前にだけ表し、属性では「一時」(時間帯から変わる場合は「のち」) として扱います。
+var weatherCatalog = map[WeatherEnum]WeatherInfo{
+	"100": newWeatherInfo("晴れ", ConditionSunny, TransitionNone, ConditionNone),
+	"101": newWeatherInfo("晴れ時々くもり", ConditionSunny, TransitionSometimes, ConditionCloudy),
+	"102": newWeatherInfo("晴れ一時雨", ConditionSunny, TransitionTemporarily, ConditionRain),
+	"103": newWeatherInfo("晴れ時々雨", ConditionSunny, TransitionSometimes, ConditionRain),
+	"104": newWeatherInfo("晴れ一時雪", ConditionSunny, TransitionTemporarily, ConditionSnow),
+	"105": newWeatherInfo("晴れ時々雪", ConditionSunny, TransitionSometimes, ConditionSnow),
+	"106": newWeatherInfo("晴れ一時雨か雪", ConditionSunny, TransitionTemporarily, ConditionSleet),
+	"107": newWeatherInfo("晴れ時々雨か雪", ConditionSunny, TransitionSometimes, ConditionSleet),
+	"108": newWeatherInfo("晴れ一時雨か雷雨", ConditionSunny, TransitionTemporarily, ConditionThunderstorm),
+	"110": newWeatherInfo("晴れのち時々くもり", ConditionSunny, TransitionLater, ConditionCloudy),
+	"111": newWeatherInfo("晴れのちくもり", ConditionSunny, TransitionLater, ConditionCloudy),
+	"112": newWeatherInfo("晴れのち一時雨", ConditionSunny, TransitionLater, ConditionRain),
+	"113": newWeatherInfo("晴れのち時々雨", ConditionSunny, TransitionLater, ConditionRain),
+	"114": newWeatherInfo("晴れのち雨", ConditionSunny, TransitionLater, ConditionRain),
+	"115": newWeatherInfo("晴れのち一時雪", ConditionSunny, TransitionLater, ConditionSnow),
+	"116": newWeatherInfo("晴れのち時々雪", ConditionSunny, TransitionLater, ConditionSnow),
+	"117": newWeatherInfo("晴れのち雪", ConditionSunny, TransitionLater, ConditionSnow),
+	"118": newWeatherInfo("晴れのち雨か雪", ConditionSunny, TransitionLater, ConditionSleet),
+	"119": newWeatherInfo("晴れのち雨か雷雨", ConditionSunny, TransitionLater, ConditionThunderstorm),
+	"120": newWeatherInfo("晴れ朝夕一時雨", ConditionSunny, TransitionTemporarily, ConditionRain),
+	"121": newWeatherInfo("晴れ朝の内一時雨", ConditionSunny, TransitionTemporarily, ConditionRain),
+	"122": newWeatherInfo("晴れ夕方一時雨", ConditionSunny, TransitionTemporarily, ConditionRain),
+	"123": newWeatherInfo("晴れ山沿い雷雨", ConditionSunny, TransitionTemporarily, ConditionThunderstorm),
+	"124": newWeatherInfo("晴れ山沿い雪", ConditionSunny, TransitionTemporarily, ConditionSnow),
+	"125": newWeatherInfo("晴れ午後は雷雨", ConditionSunny, TransitionLater, ConditionThunderstorm),
+	"126": newWeatherInfo("晴れ昼頃から雨", ConditionSunny, TransitionLater, ConditionRain),
+	"127": newWeatherInfo("晴れ夕方から雨", ConditionSunny, TransitionLater, ConditionRain),
+	"128": newWeatherInfo("晴れ夜は雨", ConditionSunny, TransitionLater, ConditionRain),
+	"130": newWeatherInfo("朝の内霧のち晴れ", ConditionFog, TransitionLater, ConditionSunny),
+	"131": newWeatherInfo("晴れ明け方霧", ConditionSunny, TransitionTemporarily, ConditionFog),
+	"132": newWeatherInfo("晴れ朝夕くもり", ConditionSunny, TransitionTemporarily, ConditionCloudy),
+	"140": newWeatherInfo("晴れ時々雨で雷を伴う", ConditionSunny, TransitionSometimes, ConditionThunderstorm),
+	"160": newWeatherInfo("晴れ一時雪か雨", ConditionSunny, TransitionTemporarily, ConditionSleet),
+	"170": newWeatherInfo("晴れ時々雪か雨", ConditionSunny, TransitionSometimes, ConditionSleet),
+	"181": newWeatherInfo("晴れのち雪か雨", ConditionSunny, TransitionLater, ConditionSleet),
+
+	"200": newWeatherInfo("くもり", ConditionCloudy, TransitionNone, ConditionNone),
+	"201": newWeatherInfo("くもり時々晴れ", ConditionCloudy, TransitionSometimes, ConditionSunny),
+	"202": newWeatherInfo("くもり一時雨", ConditionCloudy, TransitionTemporarily, ConditionRain),
+	"203": newWeatherInfo("くもり時々雨", ConditionCloudy, TransitionSometimes, ConditionRain),
+	"204": newWeatherInfo("くもり一時雪", ConditionCloudy, TransitionTemporarily, ConditionSnow),
+	"205": newWeatherInfo("くもり時々雪", ConditionCloudy, TransitionSometimes, ConditionSnow),
+	"206": newWeatherInfo("くもり一時雨か雪", ConditionCloudy, TransitionTemporarily, ConditionSleet),
+	"207": newWeatherInfo("くもり時々雨か雪", ConditionCloudy, TransitionSometimes, ConditionSleet),
+	"208": newWeatherInfo("くもり一時雨か雷雨", ConditionCloudy, TransitionTemporarily, ConditionThunderstorm),
+	"209": newWeatherInfo("霧", ConditionFog, TransitionNone, ConditionNone),
+	"210": newWeatherInfo("くもりのち時々晴れ", ConditionCloudy, TransitionLater, ConditionSunny),
+	"211": newWeatherInfo("くもりのち晴れ", ConditionCloudy, TransitionLater, ConditionSunny),
+	"212": newWeatherInfo("くもりのち一時雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"213": newWeatherInfo("くもりのち時々雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"214": newWeatherInfo("くもりのち雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"215": newWeatherInfo("くもりのち一時雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"216": newWeatherInfo("くもりのち時々雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"217": newWeatherInfo("くもりのち雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"218": newWeatherInfo("くもりのち雨か雪", ConditionCloudy, TransitionLater, ConditionSleet),
+	"219": newWeatherInfo("くもりのち雨か雷雨", ConditionCloudy, TransitionLater, ConditionThunderstorm),
+	"220": newWeatherInfo("くもり朝夕一時雨", ConditionCloudy, TransitionTemporarily, ConditionRain),
+	"221": newWeatherInfo("くもり朝の内一時雨", ConditionCloudy, TransitionTemporarily, ConditionRain),
+	"222": newWeatherInfo("くもり夕方一時雨", ConditionCloudy, TransitionTemporarily, ConditionRain),
+	"223": newWeatherInfo("くもり日中時々晴れ", ConditionCloudy, TransitionSometimes, ConditionSunny),
+	"224": newWeatherInfo("くもり昼頃から雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"225": newWeatherInfo("くもり夕方から雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"226": newWeatherInfo("くもり夜は雨", ConditionCloudy, TransitionLater, ConditionRain),
+	"228": newWeatherInfo("くもり昼頃から雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"229": newWeatherInfo("くもり夕方から雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"230": newWeatherInfo("くもり夜は雪", ConditionCloudy, TransitionLater, ConditionSnow),
+	"231": newWeatherInfo("くもり海上海岸は霧か霧雨", ConditionCloudy, TransitionTemporarily, ConditionFog),
+	"240": newWeatherInfo("くもり時々雨で雷を伴う", ConditionCloudy, TransitionSometimes, ConditionThunderstorm),
+	"250": newWeatherInfo("くもり時々雪で雷を伴う", ConditionCloudy, TransitionSometimes, ConditionSnow),
+	"260": newWeatherInfo("くもり一時雪か雨", ConditionCloudy, TransitionTemporarily, ConditionSleet),
+	"270": newWeatherInfo("くもり時々雪か雨", ConditionCloudy, TransitionSometimes, ConditionSleet),
+	"281": newWeatherInfo("くもりのち雪か雨", ConditionCloudy, TransitionLater, ConditionSleet),
+
+	"300": newWeatherInfo("雨", ConditionRain, TransitionNone, ConditionNone),
+	"301": newWeatherInfo("雨時々晴れ", ConditionRain, TransitionSometimes, ConditionSunny),
+	"302": newWeatherInfo("雨時々止む", ConditionRain, TransitionSometimes, ConditionCloudy),
+	"303": newWeatherInfo("雨時々雪", ConditionRain, TransitionSometimes, ConditionSnow),
+	"304": newWeatherInfo("雨か雪", ConditionSleet, TransitionNone, ConditionNone),
+	"306": newWeatherInfo("大雨", ConditionRain, TransitionNone, ConditionNone),
+	"308": newWeatherInfo("雨で暴風を伴う", ConditionRain, TransitionNone, ConditionNone),
+	"309": newWeatherInfo("雨一時雪", ConditionRain, TransitionTemporarily, ConditionSnow),
+	"311": newWeatherInfo("雨のち晴れ", ConditionRain, TransitionLater, ConditionSunny),
+	"313": newWeatherInfo("雨のちくもり", ConditionRain, TransitionLater, ConditionCloudy),
+	"314": newWeatherInfo("雨のち時々雪", ConditionRain, TransitionLater, ConditionSnow),
+	"315": newWeatherInfo("雨のち雪", ConditionRain, TransitionLater, ConditionSnow),
+	"316": newWeatherInfo("雨か雪のち晴れ", ConditionSleet, TransitionLater, ConditionSunny),
+	"317": newWeatherInfo("雨か雪のちくもり", ConditionSleet, TransitionLater, ConditionCloudy),
+	"320": newWeatherInfo("朝の内雨のち晴れ", ConditionRain, TransitionLater, ConditionSunny),
+	"321": newWeatherInfo("朝の内雨のちくもり", ConditionRain, TransitionLater, ConditionCloudy),
+	"322": newWeatherInfo("雨朝晩一時雪", ConditionRain, TransitionTemporarily, ConditionSnow),
+	"323": newWeatherInfo("雨昼頃から晴れ", ConditionRain, TransitionLater, ConditionSunny),
+	"324": newWeatherInfo("雨夕方から晴れ", ConditionRain, TransitionLater, ConditionSunny),
+	"325": newWeatherInfo("雨夜は晴れ", ConditionRain, TransitionLater, ConditionSunny),
+	"326": newWeatherInfo("雨夕方から雪", ConditionRain, TransitionLater, ConditionSnow),
+	"327": newWeatherInfo("雨夜は雪", ConditionRain, TransitionLater, ConditionSnow),
+	"328": newWeatherInfo("雨一時強く降る", ConditionRain, TransitionNone, ConditionNone),
+	"329": newWeatherInfo("雨一時みぞれ", ConditionRain, TransitionTemporarily, ConditionSleet),
+	"340": newWeatherInfo("雪か雨", ConditionSleet, TransitionNone, ConditionNone),
+	"350": newWeatherInfo("雨で雷を伴う", ConditionRain, TransitionNone, ConditionThunderstorm),
+	"361": newWeatherInfo("雪か雨のち晴れ", ConditionSleet, TransitionLater, ConditionSunny),
+	"371": newWeatherInfo("雪か雨のちくもり", ConditionSleet, TransitionLater, ConditionCloudy),
+
+	"400": newWeatherInfo("雪", ConditionSnow, TransitionNone, ConditionNone),
+	"401": newWeatherInfo("雪時々晴れ", ConditionSnow, TransitionSometimes, ConditionSunny),
+	"402": newWeatherInfo("雪時々止む", ConditionSnow, TransitionSometimes, ConditionCloudy),
+	"403": newWeatherInfo("雪時々雨", ConditionSnow, TransitionSometimes, ConditionRain),
+	"405": newWeatherInfo("大雪", ConditionSnow, TransitionNone, ConditionNone),
+	"406": newWeatherInfo("風雪強い", ConditionSnow, TransitionNone, ConditionNone),
+	"407": newWeatherInfo("暴風雪", ConditionSnow, TransitionNone, ConditionNone),
+	"409": newWeatherInfo("雪一時雨", ConditionSnow, TransitionTemporarily, ConditionRain),
+	"411": newWeatherInfo("雪のち晴れ", ConditionSnow, TransitionLater, ConditionSunny),
+	"413": newWeatherInfo("雪のちくもり", ConditionSnow, TransitionLater, ConditionCloudy),
+	"414": newWeatherInfo("雪のち雨", ConditionSnow, TransitionLater, ConditionRain),
+	"420": newWeatherInfo("朝の内雪のち晴れ", ConditionSnow, TransitionLater, ConditionSunny),
+	"421": newWeatherInfo("朝の内雪のちくもり", ConditionSnow, TransitionLater, ConditionCloudy),
+	"422": newWeatherInfo("雪昼頃から雨", ConditionSnow, TransitionLater, ConditionRain),
+	"423": newWeatherInfo("雪夕方から雨", ConditionSnow, TransitionLater, ConditionRain),
+	"425": newWeatherInfo("雪一時強く降る", ConditionSnow, TransitionNone, ConditionNone),
+	"426": newWeatherInfo("雪のちみぞれ", ConditionSnow, TransitionLater, ConditionSleet),
+	"427": newWeatherInfo("雪一時みぞれ", ConditionSnow, TransitionTemporarily, ConditionSleet),
+	"450": newWeatherInfo("雪で雷を伴う", ConditionSnow, TransitionNone, ConditionThunderstorm),
+}
+
+// WeatherCodes は weatherCatalog に定義されている天気コードを昇順で返します。
+func WeatherCodes() []WeatherEnum {
+	codes := make([]WeatherEnum, 0, len(weatherCatalog))
+	for code := range weatherCatalog {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Info は天気コードの属性と、weatherCatalog に定義されているかどうかを返します。
+// 定義されていないコードの場合は百の位が同じ基本の天気 (例: "199" は晴れ) の属性を返します。基本の天気も無い場合はゼロ値を返します。
+func (w WeatherEnum) Info() (WeatherInfo, bool) {
+	if info, ok := weatherCatalog[w]; ok {
+		return info, true
+	}
+	if len(w) == 3 {
+		if info, ok := weatherCatalog[w[:1]+"00"]; ok {
+			return info, false
+		}
+	}
+	return WeatherInfo{}, false
+}
+
+// String は WeatherEnum の文字列表現を返します。
+func (w WeatherEnum) String() string {
+	if info, _ := w.Info(); info.Name != "" {
+		return i18n.T(info.Name)
+	}
+	return i18n.Sprintf("不明な天気(%s)", string(w))
+}
+
+// Emoji は天気コードの絵文字を返します。対応する絵文字が無い場合は空文字列を返します。
+func (w WeatherEnum) Emoji() string {
+	info, _ := w.Info()
+	return info.Emoji
+}
+
+// NerdIcon は天気コードの Nerd Font のアイコンを返します。対応するアイコンが無い場合は空文字列を返します。
+func (w WeatherEnum) NerdIcon() string {
+	info, _ := w.Info()
+	return info.NerdIcon
+}
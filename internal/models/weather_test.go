@@ -0,0 +1,84 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+func TestWeatherEnumInfo(t *testing.T) {
+	info, ok := WeatherEnum("101").Info()
+	assert.True(t, ok)
+	assert.Equal(t, "晴れ時々くもり", info.Name)
+	assert.Equal(t, ConditionSunny, info.Primary)
+	assert.Equal(t, ConditionCloudy, info.Secondary)
+	assert.Equal(t, TransitionSometimes, info.Transition)
+	assert.False(t, info.Precipitation)
+
+	info, ok = WeatherEnum("214").Info()
+	assert.True(t, ok)
+	assert.Equal(t, TransitionLater, info.Transition)
+	assert.Equal(t, ConditionRain, info.Secondary)
+	assert.True(t, info.Precipitation, "のち雨は降水ありのはずです")
+
+	info, ok = WeatherEnum("202").Info()
+	assert.True(t, ok)
+	assert.Equal(t, TransitionTemporarily, info.Transition)
+
+	info, ok = WeatherEnum("199").Info()
+	assert.False(t, ok)
+	assert.Equal(t, ConditionSunny, info.Primary, "未定義のコードは百の位が同じ基本の天気のはずです")
+
+	info, ok = WeatherEnum("abc").Info()
+	assert.False(t, ok)
+	assert.Equal(t, WeatherInfo{}, info)
+}
+
+func TestWeatherEnumString(t *testing.T) {
+	assert.Equal(t, "晴れ", Sunny.String())
+	assert.Equal(t, "雨のちくもり", WeatherEnum("313").String())
+	assert.Equal(t, "晴れ", WeatherEnum("199").String())
+	assert.Equal(t, "不明な天気(999)", WeatherEnum("999").String())
+
+	for _, code := range WeatherCodes() {
+		assert.NotContains(t, code.String(), "不明な天気", code)
+	}
+}
+
+func TestWeatherEnumIcons(t *testing.T) {
+	assert.Equal(t, "☀", Sunny.Emoji())
+	assert.Equal(t, "🌤", WeatherEnum("101").Emoji())
+	assert.Equal(t, "☔", WeatherEnum("313").Emoji())
+	assert.Equal(t, "⛈", WeatherEnum("240").Emoji())
+	assert.Equal(t, "", Sunny.NerdIcon())
+	assert.Equal(t, "", WeatherEnum("350").NerdIcon())
+	assert.Empty(t, WeatherEnum("999").Emoji())
+
+	for _, code := range WeatherCodes() {
+		info, _ := code.Info()
+		assert.NotEmpty(t, info.Emoji, code)
+		assert.NotEmpty(t, info.NerdIcon, code)
+	}
+}
+
+func TestWeatherCatalog(t *testing.T) {
+	codes := WeatherCodes()
+	assert.Equal(t, Sunny, codes[0], "昇順のはずです")
+	for _, code := range codes {
+		info, _ := code.Info()
+		assert.Equal(t, info.Primary.Precipitation() || info.Secondary.Precipitation(), info.Precipitation, code)
+		if info.Secondary == ConditionNone {
+			assert.Equal(t, TransitionNone, info.Transition, "%s: 副次的な天気が無い場合は変わり方も無いはずです", code)
+		}
+	}
+
+	i18n.SetLang(i18n.En)
+	t.Cleanup(func() { i18n.SetLang(i18n.Ja) })
+	assert.Equal(t, "Sunny, occasionally cloudy", WeatherEnum("101").String())
+	for _, code := range codes {
+		info, _ := code.Info()
+		assert.NotEqual(t, info.Name, code.String(), "%s: 英語の翻訳があるはずです", code)
+	}
+}
@@ -0,0 +1,219 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// 各チャットサービスの制限です。超えた分は複数のブロックに分割するか切り詰めます。
+const (
+	slackMaxSectionFields = 10 // Slack の section ブロックの fields の最大数
+	discordMaxEmbeds      = 10 // Discord のメッセージの embeds の最大数
+	discordMaxFields      = 25 // Discord の embed の fields の最大数
+)
+
+// discordColors は重要度ごとの Discord の embed の色です。
+var discordColors = map[string]int{
+	"":               0x3498db, // 通常: 青
+	SeverityWarning:  0xf1c40f, // 黄
+	SeverityCritical: 0xe74c3c, // 赤
+}
+
+// teamsColors は重要度ごとの Teams の Adaptive Card の文字色です。
+var teamsColors = map[string]string{
+	"":               "default",
+	SeverityWarning:  "warning",
+	SeverityCritical: "attention",
+}
+
+// ChatFormats は Report をチャットサービスの Webhook 用の JSON に変換する関数です。
+var ChatFormats = map[string]func(Report) ([]byte, error){
+	"slack":   SlackPayload,
+	"discord": DiscordPayload,
+	"teams":   TeamsPayload,
+}
+
+// ChatFormatNames は ChatFormats の名前を昇順で返します。
+func ChatFormatNames() []string {
+	names := make([]string, 0, len(ChatFormats))
+	for name := range ChatFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SlackPayload は Report を Slack の Incoming Webhook 用の Block Kit の JSON に変換します。
+func SlackPayload(r Report) ([]byte, error) {
+	type text struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	type block struct {
+		Type   string `json:"type"`
+		Text   *text  `json:"text,omitempty"`
+		Fields []text `json:"fields,omitempty"`
+	}
+
+	blocks := []block{{Type: "header", Text: &text{Type: "plain_text", Text: r.Title}}}
+	for _, section := range r.Sections {
+		heading := "*" + section.Heading + "*"
+		if section.Summary != "" {
+			heading += "\n" + section.Summary
+		}
+		blocks = append(blocks, block{Type: "section", Text: &text{Type: "mrkdwn", Text: heading}})
+		for chunk := range slices.Chunk(section.Fields, slackMaxSectionFields) {
+			fields := make([]text, len(chunk))
+			for i, f := range chunk {
+				fields[i] = text{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", f.Name, f.Value)}
+			}
+			blocks = append(blocks, block{Type: "section", Fields: fields})
+		}
+		blocks = append(blocks, block{Type: "divider"})
+	}
+	// text は通知やブロックを表示できないクライアントで使われる代替テキスト
+	return json.Marshal(struct {
+		Text   string  `json:"text"`
+		Blocks []block `json:"blocks"`
+	}{Text: r.Title, Blocks: blocks})
+}
+
+// DiscordPayload は Report を Discord の Webhook 用の JSON に変換します。Section ごとに1つの embed になります。
+func DiscordPayload(r Report) ([]byte, error) {
+	type field struct {
+		Name   string `json:"name"`
+		Value  string `json:"value"`
+		Inline bool   `json:"inline"`
+	}
+	type embed struct {
+		Title       string  `json:"title"`
+		Description string  `json:"description,omitempty"`
+		Color       int     `json:"color"`
+		Fields      []field `json:"fields,omitempty"`
+	}
+
+	embeds := make([]embed, 0, len(r.Sections))
+	for _, section := range r.Sections[:min(len(r.Sections), discordMaxEmbeds)] {
+		e := embed{Title: section.Heading, Description: section.Summary, Color: discordColors[r.Severity]}
+		for _, f := range section.Fields[:min(len(section.Fields), discordMaxFields)] {
+			e.Fields = append(e.Fields, field{Name: f.Name, Value: f.Value, Inline: true})
+		}
+		embeds = append(embeds, e)
+	}
+	return json.Marshal(struct {
+		Username string  `json:"username"`
+		Content  string  `json:"content"`
+		Embeds   []embed `json:"embeds"`
+	}{Username: "zutool", Content: r.Title, Embeds: embeds})
+}
+
+// TeamsPayload は Report を Microsoft Teams の Webhook (Workflows) 用の Adaptive Card の JSON に変換します。
+func TeamsPayload(r Report) ([]byte, error) {
+	type fact struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	}
+	type element struct {
+		Type    string `json:"type"`
+		Text    string `json:"text,omitempty"`
+		Size    string `json:"size,omitempty"`
+		Weight  string `json:"weight,omitempty"`
+		Color   string `json:"color,omitempty"`
+		Wrap    bool   `json:"wrap,omitempty"`
+		Spacing string `json:"spacing,omitempty"`
+		Facts   []fact `json:"facts,omitempty"`
+	}
+	type card struct {
+		Schema  string    `json:"$schema"`
+		Type    string    `json:"type"`
+		Version string    `json:"version"`
+		Body    []element `json:"body"`
+	}
+	type attachment struct {
+		ContentType string `json:"contentType"`
+		Content     card   `json:"content"`
+	}
+
+	body := []element{{Type: "TextBlock", Text: r.Title, Size: "Large", Weight: "Bolder", Color: teamsColors[r.Severity], Wrap: true}}
+	for _, section := range r.Sections {
+		body = append(body, element{Type: "TextBlock", Text: section.Heading, Weight: "Bolder", Wrap: true, Spacing: "Medium"})
+		if section.Summary != "" {
+			body = append(body, element{Type: "TextBlock", Text: section.Summary, Wrap: true})
+		}
+		if len(section.Fields) > 0 {
+			facts := make([]fact, len(section.Fields))
+			for i, f := range section.Fields {
+				facts[i] = fact{Title: f.Name, Value: f.Value}
+			}
+			body = append(body, element{Type: "FactSet", Facts: facts})
+		}
+	}
+	return json.Marshal(struct {
+		Type        string       `json:"type"`
+		Attachments []attachment `json:"attachments"`
+	}{
+		Type: "message",
+		Attachments: []attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: card{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+			},
+		}},
+	})
+}
+
+// ChatSink は Report をチャットサービスの Webhook に投稿します。
+// watch コマンドの通知先として使う場合、Event は EventReport で Report に変換されます。
+type ChatSink struct {
+	Format string       // ChatFormats の名前 (例: "slack")
+	URL    string       // Webhook の URL
+	Client *http.Client // nil の場合は http.DefaultClient (タイムアウトは WebhookTimeout)
+}
+
+// NewChatSink は format の ChatSink を作成します。未知の format の場合はエラーを返します。
+func NewChatSink(format, url string) (*ChatSink, error) {
+	if _, ok := ChatFormats[format]; !ok {
+		return nil, i18n.Errorf("不明なチャットの形式です: %q (サポートされている値: %s)", format, strings.Join(ChatFormatNames(), ", "))
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, i18n.Errorf("%s の Webhook には http(s) の URL を指定してください: %q", format, url)
+	}
+	return &ChatSink{Format: format, URL: url}, nil
+}
+
+// Payload は Report を Format の JSON に変換します。
+func (s *ChatSink) Payload(r Report) ([]byte, error) {
+	format, ok := ChatFormats[s.Format]
+	if !ok {
+		return nil, i18n.Errorf("不明なチャットの形式です: %q", s.Format)
+	}
+	body, err := format(r)
+	if err != nil {
+		return nil, i18n.Errorf("%s のペイロードを作成できませんでした: %w", s.Format, err)
+	}
+	return body, nil
+}
+
+// Post は Report を Webhook に投稿します。
+func (s *ChatSink) Post(ctx context.Context, r Report) error {
+	body, err := s.Payload(r)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.Client, s.URL, body)
+}
+
+// Send は Event を Report に変換して投稿します。
+func (s *ChatSink) Send(ctx context.Context, event Event) error {
+	return s.Post(ctx, EventReport(event))
+}
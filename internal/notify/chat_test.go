@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testReport は12項目の Section を持つ Report です (Slack の fields の上限 10 を超える)。
+func testReport() Report {
+	section := Section{Heading: "渋谷区の気圧予報", Summary: "最大の気圧レベル: 警戒"}
+	for i := 0; i < 12; i++ {
+		section.Fields = append(section.Fields, Field{Name: fmt.Sprintf("%d時", i*2), Value: "1010.0hPa"})
+	}
+	return Report{Title: "頭痛予報 (home)", Severity: SeverityCritical, Sections: []Section{section}}
+}
+
+func TestSlackPayload(t *testing.T) {
+	body, err := SlackPayload(testReport())
+	require.NoError(t, err)
+
+	var payload struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type   string            `json:"type"`
+			Text   map[string]string `json:"text"`
+			Fields []map[string]string
+		} `json:"blocks"`
+	}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "頭痛予報 (home)", payload.Text, "代替テキストにはタイトルが入るはずです")
+	require.Len(t, payload.Blocks, 5, "header, 見出し, fields (10件), fields (2件), divider のはずです")
+	assert.Equal(t, "header", payload.Blocks[0].Type)
+	assert.Equal(t, "*渋谷区の気圧予報*\n最大の気圧レベル: 警戒", payload.Blocks[1].Text["text"])
+	assert.Len(t, payload.Blocks[2].Fields, 10)
+	assert.Len(t, payload.Blocks[3].Fields, 2)
+	assert.Equal(t, "divider", payload.Blocks[4].Type)
+}
+
+func TestDiscordPayload(t *testing.T) {
+	body, err := DiscordPayload(testReport())
+	require.NoError(t, err)
+
+	var payload struct {
+		Content string `json:"content"`
+		Embeds  []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			Color       int    `json:"color"`
+			Fields      []struct {
+				Name   string `json:"name"`
+				Value  string `json:"value"`
+				Inline bool   `json:"inline"`
+			} `json:"fields"`
+		} `json:"embeds"`
+	}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "頭痛予報 (home)", payload.Content)
+	require.Len(t, payload.Embeds, 1)
+	assert.Equal(t, "渋谷区の気圧予報", payload.Embeds[0].Title)
+	assert.Equal(t, discordColors[SeverityCritical], payload.Embeds[0].Color, "重要度に応じた色のはずです")
+	assert.Len(t, payload.Embeds[0].Fields, 12)
+	assert.True(t, payload.Embeds[0].Fields[0].Inline)
+}
+
+func TestTeamsPayload(t *testing.T) {
+	body, err := TeamsPayload(testReport())
+	require.NoError(t, err)
+
+	var payload struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type string `json:"type"`
+				Body []struct {
+					Type  string              `json:"type"`
+					Text  string              `json:"text"`
+					Color string              `json:"color"`
+					Facts []map[string]string `json:"facts"`
+				} `json:"body"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "message", payload.Type)
+	require.Len(t, payload.Attachments, 1)
+	card := payload.Attachments[0].Content
+	assert.Equal(t, "application/vnd.microsoft.card.adaptive", payload.Attachments[0].ContentType)
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	require.Len(t, card.Body, 4, "タイトル, 見出し, 要約, FactSet のはずです")
+	assert.Equal(t, "attention", card.Body[0].Color)
+	assert.Equal(t, "FactSet", card.Body[3].Type)
+	assert.Equal(t, map[string]string{"title": "0時", "value": "1010.0hPa"}, card.Body[3].Facts[0])
+}
+
+func TestChatSinkPost(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		received, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	for _, format := range ChatFormatNames() {
+		sink, err := NewChatSink(format, server.URL)
+		require.NoError(t, err)
+		require.NoError(t, sink.Post(context.Background(), testReport()), format)
+
+		expected, err := ChatFormats[format](testReport())
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(received), "%s のペイロードが POST されるはずです", format)
+	}
+
+	// watch の通知先として Event を投稿できる
+	sink, err := NewChatSink("slack", server.URL)
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), testEvent))
+	assert.Contains(t, string(received), testEvent.Title)
+}
+
+func TestNewChatSinkInvalid(t *testing.T) {
+	_, err := NewChatSink("line", "https://example.com")
+	assert.Error(t, err)
+	_, err = NewChatSink("slack", "example.com")
+	assert.Error(t, err)
+}
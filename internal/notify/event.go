@@ -0,0 +1,167 @@
+// Package notify は気圧レベルや痛み予報の警報を検出し、通知先 (Sink) に送信する機能を提供します。
+package notify
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// 通知の種類です。
+const (
+	KindPressureLevel = "pressure_level" // 気圧レベルが閾値以上になる時間帯がある
+	KindPainRate      = "pain_rate"      // 痛み予報の割合が閾値以上
+)
+
+// 通知の重要度です。
+const (
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Event は通知する1件の警報です。
+// Key は同じ警報を1回だけ通知するための識別子で、地点・種類・時間帯の開始時刻・レベル (痛み予報の場合は重要度) から作られます。
+type Event struct {
+	Key      string    `json:"key"`
+	Kind     string    `json:"kind"`
+	Severity string    `json:"severity"`
+	Location string    `json:"location"`
+	Title    string    `json:"title"`
+	Message  string    `json:"message"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+}
+
+// Rules は警報を検出する条件です。
+type Rules struct {
+	Within      time.Duration            // 現在時刻からこの期間内の気圧予報を対象にする
+	Level       models.PressureLevelEnum // この気圧レベル以上で通知する
+	PainfulRate float64                  // 「痛い」以上の割合 (%) がこの値以上で通知する。0 の場合は通知しない
+	BadRate     float64                  // 「かなり痛い」の割合 (%) がこの値以上で重要な通知をする。0 の場合は通知しない
+}
+
+// DefaultRules は watch コマンドのデフォルトの検出条件です。
+var DefaultRules = Rules{
+	Within:      6 * time.Hour,
+	Level:       models.Alert,
+	PainfulRate: 50,
+	BadRate:     20,
+}
+
+// PressureEvents は now より後、now+Within 以内に気圧レベルが Rules.Level 以上になる時間帯を Event として返します。
+// 連続する時間は1件の Event にまとめます。時間帯の開始時刻・終了時刻・最大レベルは now で切り詰めずに予報全体から求め、
+// Key には地点・種類・時間帯の開始時刻・最大レベルを含めるため、時間帯が始まった後に再度検出しても同じ Key になり、
+// 予報が更新されて警戒から厳重警戒に上がった場合は改めて通知されます。
+func (r Rules) PressureEvents(location string, data models.GetWeatherStatusResponse, now time.Time) []Event {
+	var events []Event
+	var current *Event
+	var maxLevel models.PressureLevelEnum
+	inRange := false // 時間帯に now より後、now+Within 以内の時刻が含まれる
+	flush := func() {
+		if current == nil {
+			return
+		}
+		if inRange {
+			current.Key = fmt.Sprintf("%s:%s:%s:%s", KindPressureLevel, location, current.Start.Format(time.RFC3339), maxLevel)
+			current.Severity = SeverityWarning
+			if analysis.LevelRank(maxLevel) >= analysis.LevelRank(models.SevereAlert) {
+				current.Severity = SeverityCritical
+			}
+			current.Title = i18n.Sprintf("%s: 気圧レベル%s", location, maxLevel.String())
+			current.Message = i18n.Sprintf("%sで%s〜%sに気圧レベルが%sになる予報です。",
+				placeName(location, data), current.Start.Format("01/02 15:04"), current.End.Format("15:04"), maxLevel.String())
+			events = append(events, *current)
+		}
+		current = nil
+		inRange = false
+	}
+
+	for offset := -1; offset <= 2; offset++ {
+		dayData, _ := data.ByDayOffset(offset)
+		for _, byTime := range dayData {
+			if analysis.LevelRank(byTime.PressureLevel) < analysis.LevelRank(r.Level) {
+				flush()
+				continue
+			}
+			if current != nil && !byTime.At.Equal(current.End) {
+				flush()
+			}
+			if current == nil {
+				current = &Event{Kind: KindPressureLevel, Location: location, Start: byTime.At}
+				maxLevel = byTime.PressureLevel
+			}
+			// End は時間帯の終わり (最後の時刻の1時間後)
+			current.End = byTime.At.Add(time.Hour)
+			if analysis.LevelRank(byTime.PressureLevel) > analysis.LevelRank(maxLevel) {
+				maxLevel = byTime.PressureLevel
+			}
+			if byTime.At.After(now) && !byTime.At.After(now.Add(r.Within)) {
+				inRange = true
+			}
+		}
+	}
+	flush()
+	return events
+}
+
+// PainEvents は痛み予報の割合が閾値以上の場合に Event を返します。
+// 「かなり痛い」の割合が BadRate 以上の場合は重要な通知、そうでなく「痛い」以上の割合が PainfulRate 以上の場合は通常の通知になります。
+// 痛み予報は時刻 (時) のみを返すため、Start と End は now の日付 (日本時間) の時刻として扱います。
+func (r Rules) PainEvents(location string, data models.GetPainStatusResponse, now time.Time) []Event {
+	status := data.PainnoterateStatus
+	painful := status.RatePainful + status.RateBad
+
+	var severity string
+	switch {
+	case r.BadRate > 0 && status.RateBad >= r.BadRate:
+		severity = SeverityCritical
+	case r.PainfulRate > 0 && painful >= r.PainfulRate:
+		severity = SeverityWarning
+	default:
+		return nil
+	}
+
+	start, end := painPeriod(status, now)
+	return []Event{{
+		Key:      fmt.Sprintf("%s:%s:%s:%s", KindPainRate, location, start.Format(time.RFC3339), severity),
+		Kind:     KindPainRate,
+		Severity: severity,
+		Location: location,
+		Title:    i18n.Sprintf("%s: 頭痛に注意", location),
+		Message: i18n.Sprintf("%sの%s時〜%s時の痛み予報: 痛い %.0f%%、かなり痛い %.0f%%",
+			i18n.PlaceName(status.AreaName), status.TimeStart, status.TimeEnd, status.RatePainful, status.RateBad),
+		Start: start,
+		End:   end,
+	}}
+}
+
+// painPeriod は痛み予報の TimeStart と TimeEnd (時) を now の日付の時刻に変換します。
+// 終了が開始以前の場合は翌日とみなします。時刻を解析できない場合は両方とも now の時刻 (時) を返します。
+func painPeriod(status models.GetPainStatus, now time.Time) (time.Time, time.Time) {
+	now = now.In(models.JST)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, models.JST)
+	startHour, err1 := strconv.Atoi(status.TimeStart)
+	endHour, err2 := strconv.Atoi(status.TimeEnd)
+	if err1 != nil || err2 != nil {
+		hour := now.Truncate(time.Hour)
+		return hour, hour
+	}
+	start := day.Add(time.Duration(startHour) * time.Hour)
+	end := day.Add(time.Duration(endHour) * time.Hour)
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end
+}
+
+// placeName は地点名を返します。レスポンスに地点名が無い場合は location を返します。
+func placeName(location string, data models.GetWeatherStatusResponse) string {
+	if data.PlaceName == "" {
+		return location
+	}
+	return i18n.PlaceName(data.PlaceName)
+}
@@ -0,0 +1,89 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestPressureEvents(t *testing.T) {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	levels := []models.PressureLevelEnum{
+		models.Alert, models.Normal, models.Normal, models.Alert, // 0-3時
+		models.SevereAlert, models.Alert, models.Normal, models.Alert, // 4-7時
+		models.Alert, models.Alert, // 8-9時
+	}
+	data := models.GetWeatherStatusResponse{PlaceName: "渋谷区"}
+	for i, level := range levels {
+		data.Today = append(data.Today, models.WeatherStatusByTime{Hour: i, PressureLevel: level, At: today.Add(time.Duration(i) * time.Hour)})
+	}
+
+	rules := Rules{Within: 7 * time.Hour, Level: models.Alert}
+	events := rules.PressureEvents("home", data, today) // 0時 (現在時刻) と 8時以降は対象外
+	require.Len(t, events, 2)
+
+	first := events[0]
+	assert.Equal(t, KindPressureLevel, first.Kind)
+	assert.Equal(t, today.Add(3*time.Hour), first.Start)
+	assert.Equal(t, today.Add(6*time.Hour), first.End)
+	assert.Equal(t, SeverityCritical, first.Severity, "厳重警戒を含む時間帯は重要な通知のはずです")
+	assert.Contains(t, first.Key, "home")
+	assert.Contains(t, first.Message, "渋谷区")
+
+	assert.Equal(t, today.Add(7*time.Hour), events[1].Start)
+	assert.Equal(t, SeverityWarning, events[1].Severity)
+
+	assert.Equal(t, today.Add(10*time.Hour), events[1].End, "時間帯は Within で切り詰められないはずです")
+
+	// 時間が経過しても時間帯の開始時刻は変わらないため Key も変わらない
+	again := rules.PressureEvents("home", data, today.Add(time.Hour))
+	require.NotEmpty(t, again)
+	assert.Equal(t, first.Key, again[0].Key)
+
+	// 時間帯が始まった後や厳重警戒の時間が過ぎた後も同じ Key になる
+	for _, now := range []time.Time{today.Add(3*time.Hour + 30*time.Minute), today.Add(4*time.Hour + 30*time.Minute)} {
+		during := rules.PressureEvents("home", data, now)
+		require.NotEmpty(t, during, now)
+		assert.Equal(t, first.Key, during[0].Key, now)
+		assert.Equal(t, first.Start, during[0].Start, now)
+		assert.Equal(t, SeverityCritical, during[0].Severity, now)
+	}
+
+	// 時間帯が終わった後は通知しない
+	after := rules.PressureEvents("home", data, today.Add(6*time.Hour))
+	require.Len(t, after, 1)
+	assert.Equal(t, events[1].Key, after[0].Key)
+
+	// 予報が更新されて同じ時間帯が厳重警戒に上がった場合は Key が変わり、改めて通知される
+	data.Today[8].PressureLevel = models.SevereAlert
+	escalated := rules.PressureEvents("home", data, today.Add(7*time.Hour+30*time.Minute))
+	require.Len(t, escalated, 1)
+	assert.Equal(t, events[1].Start, escalated[0].Start)
+	assert.Equal(t, SeverityCritical, escalated[0].Severity)
+	assert.NotEqual(t, events[1].Key, escalated[0].Key, "レベルが上がった場合は別の Key のはずです")
+}
+
+func TestPainEvents(t *testing.T) {
+	now := time.Date(2025, 5, 20, 9, 30, 0, 0, models.JST)
+	data := models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{
+		AreaName: "東京都", TimeStart: "21", TimeEnd: "03", RatePainful: 30, RateBad: 10,
+	}}
+
+	assert.Empty(t, Rules{PainfulRate: 50, BadRate: 20}.PainEvents("home", data, now), "閾値未満では通知しないはずです")
+
+	events := Rules{PainfulRate: 40, BadRate: 20}.PainEvents("home", data, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, SeverityWarning, events[0].Severity)
+	assert.Equal(t, time.Date(2025, 5, 20, 21, 0, 0, 0, models.JST), events[0].Start)
+	assert.Equal(t, time.Date(2025, 5, 21, 3, 0, 0, 0, models.JST), events[0].End, "終了が開始以前の場合は翌日のはずです")
+
+	events = Rules{PainfulRate: 40, BadRate: 10}.PainEvents("home", data, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, SeverityCritical, events[0].Severity)
+
+	assert.Empty(t, Rules{}.PainEvents("home", data, now), "閾値が 0 の場合は通知しないはずです")
+}
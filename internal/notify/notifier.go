@@ -0,0 +1,110 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// DefaultRetention は通知済みの Event を重複排除のために記録しておく期間です。
+const DefaultRetention = 48 * time.Hour
+
+// Notifier は Event を全ての Sink に送信します。
+// 送信済みの Event は Key で記録され、同じ Key の Event は再度送信されません。
+type Notifier struct {
+	Sinks     []Sink
+	StatePath string        // 通知済みの Key を保存するファイル (空の場合はプロセス内でのみ記録する)
+	Retention time.Duration // 通知済みの Key を記録しておく期間 (0 の場合は DefaultRetention)
+
+	sent map[string]time.Time
+	now  func() time.Time // テスト用に差し替え可能な現在時刻
+}
+
+// NewNotifier は Notifier を作成し、statePath が指定されていれば通知済みの Key を読み込みます。
+func NewNotifier(sinks []Sink, statePath string) (*Notifier, error) {
+	n := &Notifier{Sinks: sinks, StatePath: statePath, sent: make(map[string]time.Time), now: time.Now}
+	if statePath == "" {
+		return n, nil
+	}
+	data, err := os.ReadFile(statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return n, nil
+	}
+	if err != nil {
+		return nil, i18n.Errorf("通知の状態ファイルを読み込めませんでした: %w", err)
+	}
+	if err := json.Unmarshal(data, &n.sent); err != nil {
+		return nil, i18n.Errorf("通知の状態ファイルを解析できませんでした (%s): %w", statePath, err)
+	}
+	return n, nil
+}
+
+// Notify は未送信の Event を全ての Sink に送信し、送信した件数を返します。
+// 一部の Sink で失敗しても残りの Sink には送信し、1つでも成功した Event は送信済みとして記録します。
+// 全ての Sink で失敗した Event は記録しないため、次回の Notify で再送されます。
+func (n *Notifier) Notify(ctx context.Context, events []Event) (int, error) {
+	now := n.now()
+	var errs []error
+	sentCount := 0
+	for _, event := range events {
+		if _, ok := n.sent[event.Key]; ok {
+			continue
+		}
+		delivered := false
+		for _, sink := range n.Sinks {
+			if err := sink.Send(ctx, event); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			delivered = true
+		}
+		if delivered {
+			n.sent[event.Key] = now
+			sentCount++
+		}
+	}
+
+	if err := n.save(now); err != nil {
+		errs = append(errs, err)
+	}
+	return sentCount, errors.Join(errs...)
+}
+
+// Sent は Key の Event が送信済みかどうかを返します。
+func (n *Notifier) Sent(key string) bool {
+	_, ok := n.sent[key]
+	return ok
+}
+
+// save は保存期間を過ぎた Key を削除し、StatePath が指定されていれば通知済みの Key を保存します。
+func (n *Notifier) save(now time.Time) error {
+	retention := n.Retention
+	if retention == 0 {
+		retention = DefaultRetention
+	}
+	for key, sentAt := range n.sent {
+		if now.Sub(sentAt) > retention {
+			delete(n.sent, key)
+		}
+	}
+	if n.StatePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(n.sent, "", "  ")
+	if err != nil {
+		return i18n.Errorf("通知の状態をマーシャリングできませんでした: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(n.StatePath), 0o755); err != nil {
+		return i18n.Errorf("通知の状態ファイルのディレクトリを作成できませんでした: %w", err)
+	}
+	if err := os.WriteFile(n.StatePath, data, 0o644); err != nil {
+		return i18n.Errorf("通知の状態ファイルを保存できませんでした: %w", err)
+	}
+	return nil
+}
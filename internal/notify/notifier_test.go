@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink は送信された Event を記録する Sink です。err が設定されている場合は送信に失敗します。
+type recordingSink struct {
+	events []Event
+	err    error
+}
+
+func (s *recordingSink) Send(ctx context.Context, event Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestNotifierDeduplicates(t *testing.T) {
+	sink := &recordingSink{}
+	statePath := filepath.Join(t.TempDir(), "state", "watch.json")
+	notifier, err := NewNotifier([]Sink{sink}, statePath)
+	require.NoError(t, err)
+
+	events := []Event{{Key: "a"}, {Key: "b"}, {Key: "a"}}
+	sent, err := notifier.Notify(context.Background(), events)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent, "同じ Key の Event は1回だけ送信されるはずです")
+
+	sent, err = notifier.Notify(context.Background(), events)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Len(t, sink.events, 2)
+
+	// 状態ファイルから読み込んだ Notifier も送信済みの Event を送信しない
+	restarted, err := NewNotifier([]Sink{sink}, statePath)
+	require.NoError(t, err)
+	assert.True(t, restarted.Sent("a"))
+	sent, err = restarted.Notify(context.Background(), []Event{{Key: "b"}, {Key: "c"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
+
+func TestNotifierRetriesFailedEvents(t *testing.T) {
+	failing := &recordingSink{err: errors.New("送信失敗")}
+	notifier, err := NewNotifier([]Sink{failing}, "")
+	require.NoError(t, err)
+
+	sent, err := notifier.Notify(context.Background(), []Event{{Key: "a"}})
+	assert.Error(t, err)
+	assert.Equal(t, 0, sent)
+	assert.False(t, notifier.Sent("a"), "全ての Sink で失敗した Event は次回再送されるはずです")
+
+	working := &recordingSink{}
+	notifier.Sinks = []Sink{failing, working}
+	sent, err = notifier.Notify(context.Background(), []Event{{Key: "a"}})
+	assert.Error(t, err, "一部の Sink の失敗はエラーとして返されるはずです")
+	assert.Equal(t, 1, sent)
+	assert.True(t, notifier.Sent("a"))
+}
+
+func TestNotifierRetention(t *testing.T) {
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	notifier, err := NewNotifier([]Sink{&recordingSink{}}, "")
+	require.NoError(t, err)
+	notifier.now = func() time.Time { return now }
+
+	_, err = notifier.Notify(context.Background(), []Event{{Key: "a"}})
+	require.NoError(t, err)
+
+	now = now.Add(DefaultRetention + time.Minute)
+	_, err = notifier.Notify(context.Background(), nil)
+	require.NoError(t, err)
+	assert.False(t, notifier.Sent("a"), "保存期間を過ぎた Key は削除されるはずです")
+}
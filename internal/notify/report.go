@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// Report はチャットに投稿するメッセージです。チャットサービスに依存しない形で内容を保持し、
+// SlackPayload などで各サービスのペイロードに変換します。
+type Report struct {
+	Title    string    `json:"title"`
+	Severity string    `json:"severity,omitempty"` // SeverityWarning, SeverityCritical または空 (通常)
+	Sections []Section `json:"sections"`
+}
+
+// Section は Report の1つのまとまり (例: 痛み予報) です。
+type Section struct {
+	Heading string  `json:"heading"`
+	Summary string  `json:"summary,omitempty"`
+	Fields  []Field `json:"fields,omitempty"`
+}
+
+// Field は Section 内の項目名と値の組です。
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// EventReport は警報 (Event) を Report に変換します。
+func EventReport(event Event) Report {
+	section := Section{Heading: event.Location, Summary: event.Message}
+	if !event.Start.IsZero() && !event.End.IsZero() {
+		section.Fields = []Field{{Name: i18n.T("期間"), Value: fmt.Sprintf("%s 〜 %s", event.Start.Format("01/02 15:04"), event.End.Format("01/02 15:04"))}}
+	}
+	return Report{Title: event.Title, Severity: event.Severity, Sections: []Section{section}}
+}
+
+// PainStatusSection は痛み予報を Section に変換します。
+func PainStatusSection(data models.GetPainStatusResponse) Section {
+	status := data.PainnoterateStatus
+	return Section{
+		Heading: i18n.Sprintf("%sの痛み予報", i18n.PlaceName(status.AreaName)),
+		Summary: i18n.Sprintf("%s時〜%s時", status.TimeStart, status.TimeEnd),
+		Fields: []Field{
+			{Name: i18n.T("普通"), Value: fmt.Sprintf("%.0f%%", status.RateNormal)},
+			{Name: i18n.T("少し痛い"), Value: fmt.Sprintf("%.0f%%", status.RateLittle)},
+			{Name: i18n.T("痛い"), Value: fmt.Sprintf("%.0f%%", status.RatePainful)},
+			{Name: i18n.T("かなり痛い"), Value: fmt.Sprintf("%.0f%%", status.RateBad)},
+		},
+	}
+}
+
+// dayLabels は日付オフセット (-1: 昨日 〜 2: 明後日) の表示名です。
+var dayLabels = map[int]string{-1: "昨日", 0: "今日", 1: "明日", 2: "明後日"}
+
+// WeatherStatusSection は指定した日 (dayOffset) の気圧予報を3時間ごとの Field と、
+// 最も高い気圧レベルと最も急な気圧低下の要約を持つ Section に変換します。
+func WeatherStatusSection(data models.GetWeatherStatusResponse, dayOffset int) (Section, error) {
+	dayData, ok := data.ByDayOffset(dayOffset)
+	if !ok {
+		return Section{}, i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+	}
+	section := Section{Heading: i18n.Sprintf("%sの気圧予報 (%s)", i18n.PlaceName(data.PlaceName), i18n.T(dayLabels[dayOffset]))}
+	if len(dayData) == 0 {
+		section.Summary = i18n.T("データがありません")
+		return section, nil
+	}
+	section.Heading = i18n.Sprintf("%sの気圧予報 (%s %s)", i18n.PlaceName(data.PlaceName), i18n.T(dayLabels[dayOffset]), dayData[0].At.Format("01/02"))
+
+	maxLevel := dayData[0].PressureLevel
+	for _, byTime := range dayData {
+		if analysis.LevelRank(byTime.PressureLevel) > analysis.LevelRank(maxLevel) {
+			maxLevel = byTime.PressureLevel
+		}
+		if byTime.Hour%3 != 0 {
+			continue
+		}
+		section.Fields = append(section.Fields, Field{
+			Name:  i18n.Sprintf("%d時", byTime.Hour),
+			Value: fmt.Sprintf("%s %.1fhPa %s", weatherEmoji(byTime.Weather), byTime.Pressure, byTime.PressureLevel.String()),
+		})
+	}
+
+	summary := []string{i18n.Sprintf("最大の気圧レベル: %s", maxLevel.String())}
+	result := analysis.AnalyzePressure(data, analysis.Options{DayOffsets: []int{dayOffset}})
+	if drop := result.SteepestDrop; drop != nil {
+		summary = append(summary, i18n.Sprintf("最も急な気圧低下: %s〜%s (%+.1fhPa)", drop.Start.Format("15:04"), drop.End.Format("15:04"), drop.Change))
+	}
+	section.Summary = strings.Join(summary, " / ")
+	return section, nil
+}
+
+// DailyForecastSection は Otenki ASP の日別予報を1日1項目の Section に変換します。
+func DailyForecastSection(cityName string, forecasts []models.DailyForecast) Section {
+	section := Section{Heading: i18n.Sprintf("%sの週間予報", i18n.PlaceName(cityName))}
+	for _, f := range forecasts {
+		values := []string{weatherEmoji(f.Weather)}
+		if f.HighTemp != nil && f.LowTemp != nil {
+			values = append(values, fmt.Sprintf("%.1f/%.1f℃", *f.HighTemp, *f.LowTemp))
+		}
+		if f.Precipitation != nil {
+			values = append(values, i18n.Sprintf("降水%d%%", *f.Precipitation))
+		}
+		if f.HeadacheLevel != nil {
+			values = append(values, i18n.Sprintf("頭痛:%s", f.HeadacheLevel.String()))
+		}
+		section.Fields = append(section.Fields, Field{Name: f.Date.Format("01/02"), Value: strings.Join(values, " ")})
+	}
+	if len(section.Fields) == 0 {
+		section.Summary = i18n.T("データがありません")
+	}
+	return section
+}
+
+// weatherEmoji は天気コードを絵文字に変換します。対応する絵文字が無い場合は天気の名前を返します。
+func weatherEmoji(weather models.WeatherEnum) string {
+	if emoji := weather.Emoji(); emoji != "" {
+		return emoji
+	}
+	return weather.String()
+}
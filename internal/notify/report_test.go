@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestPainStatusSection(t *testing.T) {
+	section := PainStatusSection(models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{
+		AreaName: "東京都", TimeStart: "12", TimeEnd: "17", RateNormal: 46, RateLittle: 28, RatePainful: 17.4, RateBad: 8.6,
+	}})
+	assert.Equal(t, "東京都の痛み予報", section.Heading)
+	assert.Equal(t, "12時〜17時", section.Summary)
+	require.Len(t, section.Fields, 4)
+	assert.Equal(t, Field{Name: "かなり痛い", Value: "9%"}, section.Fields[3])
+}
+
+func TestWeatherStatusSection(t *testing.T) {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{PlaceName: "渋谷区"}
+	for hour := 0; hour < 24; hour++ {
+		level := models.Normal
+		if hour == 19 {
+			level = models.Alert
+		}
+		data.Today = append(data.Today, models.WeatherStatusByTime{
+			Hour: hour, Weather: models.Sunny, Pressure: 1010 - float64(hour)*0.5, PressureLevel: level, At: today.Add(time.Duration(hour) * time.Hour),
+		})
+	}
+
+	section, err := WeatherStatusSection(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "渋谷区の気圧予報 (今日 05/20)", section.Heading)
+	require.Len(t, section.Fields, 8, "3時間ごとの項目のはずです")
+	assert.Equal(t, Field{Name: "3時", Value: "☀ 1008.5hPa 通常"}, section.Fields[1])
+	assert.Contains(t, section.Summary, "最大の気圧レベル: 警戒", "3時間ごとの項目に含まれない時刻のレベルも要約に含まれるはずです")
+	assert.Contains(t, section.Summary, "-1.5hPa")
+
+	section, err = WeatherStatusSection(data, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "データがありません", section.Summary)
+
+	_, err = WeatherStatusSection(data, 3)
+	assert.Error(t, err)
+}
+
+func TestDailyForecastSection(t *testing.T) {
+	level := models.HeadacheLevelAlert
+	precipitation := 80
+	forecasts := []models.DailyForecast{{
+		Date: time.Date(2025, 5, 22, 0, 0, 0, 0, models.JST), Weather: models.Rain, Precipitation: &precipitation,
+		HighTemp: models.NewFloat64(19.8), LowTemp: models.NewFloat64(15.4), HeadacheLevel: &level,
+	}, {
+		Date: time.Date(2025, 5, 23, 0, 0, 0, 0, models.JST), Weather: models.Cloudy,
+	}}
+
+	section := DailyForecastSection("東京", forecasts)
+	assert.Equal(t, "東京の週間予報", section.Heading)
+	require.Len(t, section.Fields, 2)
+	assert.Equal(t, Field{Name: "05/22", Value: "☔ 19.8/15.4℃ 降水80% 頭痛:警戒"}, section.Fields[0])
+	assert.Equal(t, Field{Name: "05/23", Value: "☁"}, section.Fields[1], "値の無い項目は省略されるはずです")
+}
+
+func TestEventReport(t *testing.T) {
+	start := time.Date(2025, 5, 20, 12, 0, 0, 0, models.JST)
+	report := EventReport(Event{Title: "home: 気圧レベル警戒", Severity: SeverityWarning, Location: "home", Message: "注意", Start: start, End: start.Add(3 * time.Hour)})
+	assert.Equal(t, "home: 気圧レベル警戒", report.Title)
+	assert.Equal(t, SeverityWarning, report.Severity)
+	require.Len(t, report.Sections, 1)
+	assert.Equal(t, []Field{{Name: "期間", Value: "05/20 12:00 〜 05/20 15:00"}}, report.Sections[0].Fields)
+}
@@ -0,0 +1,165 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+// Sink は Event の通知先です。
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// WriterSink は Event を1行のテキストとして Writer に出力します。
+type WriterSink struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+}
+
+// Send は "[重要度] タイトル - メッセージ" の形式で Event を出力します。
+func (s *WriterSink) Send(ctx context.Context, event Event) error {
+	w := s.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+	_, err := fmt.Fprintf(w, "[%s] %s - %s\n", event.Severity, event.Title, event.Message)
+	if err != nil {
+		return i18n.Errorf("通知の出力に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// CommandSink は Event ごとにコマンドを実行します。
+// Event は JSON として標準入力に渡され、ZUTOOL_EVENT_KEY などの環境変数にも設定されます。
+type CommandSink struct {
+	Command string
+	Args    []string
+}
+
+// Send はコマンドを実行し、終了コードが 0 以外の場合はエラーを返します。
+func (s *CommandSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return i18n.Errorf("通知をJSONにマーシャリングできませんでした: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, s.Command, s.Args...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"ZUTOOL_EVENT_KEY="+event.Key,
+		"ZUTOOL_EVENT_KIND="+event.Kind,
+		"ZUTOOL_EVENT_SEVERITY="+event.Severity,
+		"ZUTOOL_EVENT_LOCATION="+event.Location,
+		"ZUTOOL_EVENT_TITLE="+event.Title,
+		"ZUTOOL_EVENT_MESSAGE="+event.Message,
+	)
+	if err := cmd.Run(); err != nil {
+		return i18n.Errorf("通知コマンド %q の実行に失敗しました: %w", s.Command, err)
+	}
+	return nil
+}
+
+// WebhookSink は Event を JSON として URL に POST します。
+type WebhookSink struct {
+	URL    string
+	Client *http.Client // nil の場合は http.DefaultClient (タイムアウトは WebhookTimeout)
+}
+
+// Send は Event を POST し、2xx 以外のステータスコードの場合はエラーを返します。
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return i18n.Errorf("通知をJSONにマーシャリングできませんでした: %w", err)
+	}
+	return postJSON(ctx, s.Client, s.URL, body)
+}
+
+// WebhookTimeout は Webhook への1回の送信のタイムアウトです。
+// watch コマンドは長時間動作するため、応答しない Webhook で監視が止まらないよう送信ごとに期限を設けます。
+var WebhookTimeout = 30 * time.Second
+
+// postJSON は body を JSON として url に POST します。WebhookTimeout を過ぎても応答が無い場合はエラーを返します。
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, WebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return i18n.Errorf("Webhook リクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return i18n.Errorf("Webhook への送信に失敗しました: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return i18n.Errorf("Webhook がステータス %s を返しました", resp.Status)
+	}
+	return nil
+}
+
+// DesktopSink は notify-send でデスクトップ通知を表示します。
+// 重要な Event は緊急度 critical で表示されます。
+type DesktopSink struct {
+	Command string // 通知コマンド (空の場合は "notify-send")
+}
+
+// Send は notify-send を実行します。
+func (s *DesktopSink) Send(ctx context.Context, event Event) error {
+	command := s.Command
+	if command == "" {
+		command = "notify-send"
+	}
+	urgency := "normal"
+	if event.Severity == SeverityCritical {
+		urgency = "critical"
+	}
+	cmd := exec.CommandContext(ctx, command, "--app-name=zutool", "--urgency="+urgency, event.Title, event.Message)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return i18n.Errorf("デスクトップ通知に失敗しました: %w (%s)", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// SinkSpecs は ParseSink で指定できる通知先の書式です。
+var SinkSpecs = []string{"stdout", "exec:<コマンド>", "webhook:<URL>", "slack:<URL>", "discord:<URL>", "teams:<URL>", "notify-send"}
+
+// ParseSink は通知先の指定 (例: "stdout", "exec:/path/to/script arg", "webhook:https://...", "slack:https://...", "notify-send") から Sink を作成します。
+// webhook は Event をそのまま JSON で、slack, discord, teams は各サービスのペイロード (ChatFormats) で POST します。
+func ParseSink(spec string) (Sink, error) {
+	name, value, _ := strings.Cut(spec, ":")
+	switch name {
+	case "stdout":
+		return &WriterSink{Writer: os.Stdout}, nil
+	case "notify-send":
+		return &DesktopSink{}, nil
+	case "exec":
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return nil, i18n.Errorf("exec の通知先にはコマンドを指定してください (例: exec:/path/to/script)")
+		}
+		return &CommandSink{Command: fields[0], Args: fields[1:]}, nil
+	case "webhook":
+		if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+			return nil, i18n.Errorf("webhook の通知先には http(s) の URL を指定してください: %q", value)
+		}
+		return &WebhookSink{URL: value}, nil
+	case "slack", "discord", "teams":
+		return NewChatSink(name, value)
+	default:
+		return nil, i18n.Errorf("不明な通知先です: %q (サポートされている値: %s)", spec, strings.Join(SinkSpecs, ", "))
+	}
+}
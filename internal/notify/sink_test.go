@@ -0,0 +1,97 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvent = Event{Key: "pain_rate:home", Kind: KindPainRate, Severity: SeverityWarning, Location: "home", Title: "home: 頭痛に注意", Message: "痛い 30%"}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&WriterSink{Writer: &buf}).Send(context.Background(), testEvent))
+	assert.Equal(t, "[warning] home: 頭痛に注意 - 痛い 30%\n", buf.String())
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received Event
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	require.NoError(t, (&WebhookSink{URL: server.URL}).Send(context.Background(), testEvent))
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, testEvent.Key, received.Key)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	assert.Error(t, (&WebhookSink{URL: failing.URL}).Send(context.Background(), testEvent), "2xx 以外はエラーになるはずです")
+}
+
+func TestWebhookSinkTimeout(t *testing.T) {
+	timeout := WebhookTimeout
+	WebhookTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { WebhookTimeout = timeout })
+
+	release := make(chan struct{})
+	stalled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer stalled.Close()
+	defer close(release)
+
+	start := time.Now()
+	err := (&WebhookSink{URL: stalled.URL}).Send(context.Background(), testEvent)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "応答しない Webhook はタイムアウトするはずです")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestCommandSink(t *testing.T) {
+	sink := &CommandSink{Command: "sh", Args: []string{"-c", `test "$ZUTOOL_EVENT_KEY" = "pain_rate:home" && grep -q '"kind":"pain_rate"'`}}
+	assert.NoError(t, sink.Send(context.Background(), testEvent), "環境変数と標準入力に Event が渡されるはずです")
+
+	sink = &CommandSink{Command: "sh", Args: []string{"-c", "exit 1"}}
+	assert.Error(t, sink.Send(context.Background(), testEvent))
+}
+
+func TestParseSink(t *testing.T) {
+	sink, err := ParseSink("stdout")
+	require.NoError(t, err)
+	assert.IsType(t, &WriterSink{}, sink)
+
+	sink, err = ParseSink("exec:/usr/local/bin/alert --loud")
+	require.NoError(t, err)
+	assert.Equal(t, &CommandSink{Command: "/usr/local/bin/alert", Args: []string{"--loud"}}, sink)
+
+	sink, err = ParseSink("webhook:https://example.com/hook?a=1,2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook?a=1,2", sink.(*WebhookSink).URL)
+
+	sink, err = ParseSink("discord:https://discord.com/api/webhooks/1/abc")
+	require.NoError(t, err)
+	assert.Equal(t, &ChatSink{Format: "discord", URL: "https://discord.com/api/webhooks/1/abc"}, sink)
+
+	sink, err = ParseSink("notify-send")
+	require.NoError(t, err)
+	assert.IsType(t, &DesktopSink{}, sink)
+
+	for _, spec := range []string{"", "exec:", "webhook:example.com", "slack"} {
+		_, err := ParseSink(spec)
+		assert.Error(t, err, "%q はエラーになるはずです", spec)
+	}
+}
@@ -0,0 +1,12 @@
+// Package output は出力形式・色の指定・テーマの名前の一覧を提供します。
+// 設定 (config) の検証と表示 (presenter) の両方から参照するため、他のパッケージには依存しません。
+package output
+
+// Formats は出力形式の一覧です。
+var Formats = []string{"table", "json", "yaml", "ndjson", "csv", "tsv", "markdown", "html", "template"}
+
+// ColorModes は table 形式の出力に色を付けるかどうかの指定の一覧です。
+var ColorModes = []string{"auto", "always", "never"}
+
+// ThemeNames は table 形式の出力の組み込みのテーマの名前を昇順に並べた一覧です。
+var ThemeNames = []string{"256", "default", "mono"}
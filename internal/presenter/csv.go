@@ -0,0 +1,99 @@
+package presenter
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// CSVPresenter は Presenter インターフェースを実装し、データをヘッダー行付きのCSV形式で出力します。
+// 列名はコマンドごとに固定で、表計算ソフトやスクリプトでの加工を想定して値は整形せずに出力します。
+type CSVPresenter struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+	Comma  rune      // 区切り文字 (0 の場合は ',')
+}
+
+// NewTSVPresenter はタブ区切り (TSV) で出力する CSVPresenter を作成します。
+func NewTSVPresenter(w io.Writer) *CSVPresenter {
+	return &CSVPresenter{Writer: w, Comma: '\t'}
+}
+
+func (p *CSVPresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// writeRecords はヘッダー行とレコードを出力するヘルパーメソッドです。
+func (p *CSVPresenter) writeRecords(header []string, records [][]string) error {
+	w := csv.NewWriter(p.ensureWriter())
+	if p.Comma != 0 {
+		w.Comma = p.Comma
+	}
+	if err := w.Write(header); err != nil {
+		return i18n.Errorf("CSV出力の書き込みに失敗しました: %w", err)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return i18n.Errorf("CSV出力の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// PresentPainStatus は痛み予報を1行で出力します。
+func (p *CSVPresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return p.writeRecords(painStatusHeader, [][]string{newPainStatusRecord(data).values()})
+}
+
+// PresentWeatherPoint は地点検索結果を1地点1行で出力します。
+// 列を固定するため kata に関わらずカタカナ名も出力し、keyword は無視されます。
+func (p *CSVPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	records := make([][]string, 0, len(data.Result.Root))
+	for _, point := range data.Result.Root {
+		records = append(records, weatherPointValues(point))
+	}
+	return p.writeRecords(weatherPointHeader, records)
+}
+
+// PresentWeatherStatus は dayOffsets の日の気象状況を1時間1行で出力します。
+func (p *CSVPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	records, err := weatherStatusRecords(data, dayOffsets)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, record.values())
+	}
+	return p.writeRecords(weatherStatusHeader, rows)
+}
+
+// PresentOtenkiASP は targetDates の日の Otenki ASP データを1日1行で出力します。
+// 列名にはコンテンツID (例: "day_tenki") を使い、天気コードなどの値は変換せずに出力します。値が無い場合は空になります。
+func (p *CSVPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	records := otenkiASPRecords(data, targetDates, cityName, cityCode)
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, record.values())
+	}
+	return p.writeRecords(otenkiASPHeader(data), rows)
+}
+
+// PresentPressureAnalysis は1時間ごとの気圧変化量を1時間1行で出力します。
+// hourly パラメータは無視され、risk 列にはその時刻がリスク時間帯に含まれるかを出力します。
+func (p *CSVPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	records := pressureHourRecords(data)
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, record.values())
+	}
+	return p.writeRecords(pressureHourHeader, rows)
+}
+
+// コンパイル時チェック: CSVPresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*CSVPresenter)(nil)
@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestCSVPresenterWeatherStatus(t *testing.T) {
+	at := time.Date(2025, 5, 21, 9, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		PlaceID:   "113",
+		Tomorrow: []models.WeatherStatusByTime{
+			{Hour: 9, Weather: models.Rain, Temp: models.NewFloat64(18.5), Pressure: 1008.8, PressureLevel: models.Caution, At: at},
+			{Hour: 10, Weather: models.Rain, Pressure: 1008.4, PressureLevel: models.Alert, At: at.Add(time.Hour)},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&CSVPresenter{Writer: &buf}).PresentWeatherStatus(data, []int{0, 1}))
+	expected := "place_id,place_name,day,datetime,hour,weather,temp,pressure,pressure_level\n" +
+		"113,渋谷区,tomorrow,2025-05-21T09:00:00+09:00,9,300,18.5,1008.8,3\n" +
+		"113,渋谷区,tomorrow,2025-05-21T10:00:00+09:00,10,300,,1008.4,4\n"
+	assert.Equal(t, expected, buf.String(), "1時間1行で、気温が無い場合は空のはずです")
+
+	assert.Error(t, (&CSVPresenter{Writer: &buf}).PresentWeatherStatus(data, []int{3}))
+}
+
+func TestCSVPresenterOtenkiASP(t *testing.T) {
+	day1 := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	day2 := day1.AddDate(0, 0, 1)
+	data := models.GetOtenkiASPResponse{Elements: []models.Element{
+		{ContentID: models.OtenkiContentWeather, Records: map[time.Time]interface{}{day1: "100", day2: "200"}},
+		{ContentID: models.OtenkiContentHighTemp, Records: map[time.Time]interface{}{day1: 24.5}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewTSVPresenter(&buf).PresentOtenkiASP(data, []time.Time{day1, day2}, "東京", "13101"))
+	expected := "city_code\tcity_name\tdate\tday_tenki\thight_temp\n" +
+		"13101\t東京\t2025-05-20\t100\t24.5\n" +
+		"13101\t東京\t2025-05-21\t200\t\n"
+	assert.Equal(t, expected, buf.String(), "1日1行で、列名はコンテンツIDのはずです")
+}
+
+func TestNew(t *testing.T) {
+	for _, format := range Formats {
+		p, err := New(format, &bytes.Buffer{}, WithTemplate("{{.}}"))
+		require.NoError(t, err, format)
+		assert.NotNil(t, p)
+	}
+	p, err := New("tsv", nil)
+	require.NoError(t, err)
+	assert.Equal(t, '\t', p.(*CSVPresenter).Comma)
+
+	_, err = New("xml", nil)
+	assert.Error(t, err)
+}
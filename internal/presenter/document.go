@@ -0,0 +1,220 @@
+package presenter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// このファイルは Markdown や HTML など、見出し・文章・表から成る文書形式の出力で共通に使う文書の構造を定義します。
+// 各 Present メソッドのデータを document に変換し、MarkdownPresenter と HTMLPresenter がそれぞれの形式で描画します。
+
+// document は出力する文書です。
+type document struct {
+	Lang     string // 文書の言語 (例: "ja")。HTML の lang 属性に使う
+	Title    string
+	Sections []docSection
+}
+
+// docSection は見出し・文章・表から成る文書の節です。
+type docSection struct {
+	Heading string
+	Notes   []string  // 表の前に表示する文章
+	Table   *docTable // 表が無い場合は nil
+}
+
+// docTable は文書の表です。
+type docTable struct {
+	Header []string
+	Rows   [][]docCell
+}
+
+// docCell は表のセルです。Class は HTML で色分けや天気アイコンの表示に使うクラス名 (例: "level-4", "weather") で、Markdown では無視されます。
+type docCell struct {
+	Text  string
+	Class string
+}
+
+// cells は文字列をクラスの無いセルに変換するヘルパー関数です。
+func cells(texts ...string) []docCell {
+	row := make([]docCell, len(texts))
+	for i, text := range texts {
+		row[i] = docCell{Text: text}
+	}
+	return row
+}
+
+// levelCell は気圧レベルを名前で表示し、レベルに応じたクラスを持つセルを返します。
+func levelCell(level models.PressureLevelEnum) docCell {
+	return docCell{Text: level.String(), Class: "level-" + string(level)}
+}
+
+// painStatusDocument は痛み予報を文書に変換します。
+func painStatusDocument(data models.GetPainStatusResponse) document {
+	status := data.PainnoterateStatus
+	table := &docTable{Header: []string{i18n.T("痛み"), i18n.T("割合")}}
+	labels := []string{i18n.T("普通"), i18n.T("少し痛い"), i18n.T("痛い"), i18n.T("かなり痛い")}
+	rates := []float64{status.RateNormal, status.RateLittle, status.RatePainful, status.RateBad}
+	for i, label := range labels {
+		row := cells(label, fmt.Sprintf("%.0f%%", rates[i]))
+		// 痛い・かなり痛いは気圧レベルの注意・警戒と同じ色で表示する
+		switch i {
+		case 2:
+			row[1].Class = "level-" + string(models.Caution)
+		case 3:
+			row[1].Class = "level-" + string(models.Alert)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return document{
+		Title: i18n.Sprintf("%sの痛み予報", i18n.PlaceName(status.AreaName)),
+		Sections: []docSection{{
+			Notes: []string{i18n.Sprintf("%s時〜%s時", status.TimeStart, status.TimeEnd)},
+			Table: table,
+		}},
+	}
+}
+
+// weatherPointDocument は地点検索結果を文書に変換します。kata が true の場合はカタカナ名の列を含めます。
+func weatherPointDocument(data models.GetWeatherPointResponse, kata bool, keyword string) document {
+	doc := document{Title: i18n.Sprintf("「%s」の地点検索結果", keyword)}
+	if len(data.Result.Root) == 0 {
+		doc.Sections = []docSection{{Notes: []string{i18n.Sprintf("「%s」に一致する地域が見つかりませんでした。", keyword)}}}
+		return doc
+	}
+	table := &docTable{Header: []string{i18n.T("地域コード"), i18n.T("地域名")}}
+	if kata {
+		table.Header = append(table.Header, i18n.T("地域カナ"))
+	}
+	for _, point := range data.Result.Root {
+		row := cells(point.CityCode, i18n.PointName(point.Name, point.NameKata))
+		if kata {
+			row = append(row, docCell{Text: point.NameKata})
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	doc.Sections = []docSection{{Table: table}}
+	return doc
+}
+
+// dayLabels は日付オフセット (-1: 昨日 〜 2: 明後日) の表示名です。
+var dayLabels = map[int]string{-1: "昨日", 0: "今日", 1: "明日", 2: "明後日"}
+
+// weatherStatusDocument は dayOffsets の日の気象状況を、1日1節・1時間1行の文書に変換します。
+func weatherStatusDocument(data models.GetWeatherStatusResponse, dayOffsets []int) (document, error) {
+	doc := document{Title: i18n.Sprintf("%sの気圧予報", i18n.PlaceName(data.PlaceName))}
+	for _, dayOffset := range dayOffsets {
+		dayLabel, ok := dayLabels[dayOffset]
+		if !ok {
+			return document{}, i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+		}
+		section := docSection{Heading: fmt.Sprintf("%s (%s)", i18n.T(dayLabel), data.DateTime.AddDate(0, 0, dayOffset).Format("2006-01-02"))}
+		dayData, _ := data.ByDayOffset(dayOffset)
+		if len(dayData) == 0 {
+			section.Notes = []string{i18n.T("データがありません。")}
+			doc.Sections = append(doc.Sections, section)
+			continue
+		}
+
+		section.Table = &docTable{Header: []string{i18n.T("時刻"), i18n.T("天気"), i18n.T("気温"), i18n.T("気圧"), i18n.T("気圧レベル")}}
+		for _, byTime := range dayData {
+			temp := "-"
+			if byTime.Temp != nil {
+				temp = fmt.Sprintf("%.1f℃", *byTime.Temp)
+			}
+			row := []docCell{
+				{Text: i18n.Sprintf("%d時", byTime.Hour)},
+				{Text: weatherEmoji(byTime.Weather), Class: "weather"},
+				{Text: temp},
+				{Text: fmt.Sprintf("%.1f hPa", byTime.Pressure)},
+				levelCell(byTime.PressureLevel),
+			}
+			section.Table.Rows = append(section.Table.Rows, row)
+		}
+		doc.Sections = append(doc.Sections, section)
+	}
+	return doc, nil
+}
+
+// otenkiASPDocument は targetDates の日の Otenki ASP データを1日1行の文書に変換します。
+// 列の見出しと値の整形は models.OtenkiContents のコンテンツ定義に従います。
+func otenkiASPDocument(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) document {
+	doc := document{Title: i18n.Sprintf("%s (%s) の天気予報", i18n.PlaceName(cityName), cityCode)}
+	if len(data.Elements) == 0 {
+		doc.Sections = []docSection{{Notes: []string{i18n.T("表示する天気情報要素がありません。")}}}
+		return doc
+	}
+
+	contents := make([]models.OtenkiContent, len(data.Elements))
+	table := &docTable{Header: []string{i18n.T("日付")}}
+	for i, element := range data.Elements {
+		content, ok := models.LookupOtenkiContent(element.ContentID)
+		if !ok && element.Title != "" {
+			content.Title = element.Title
+		}
+		contents[i] = content
+		table.Header = append(table.Header, content.Header())
+	}
+	for _, targetDate := range targetDates {
+		row := cells(targetDate.Format("01/02"))
+		for i, element := range data.Elements {
+			cell := docCell{Text: "-"}
+			if value, ok := element.Records[targetDate]; ok {
+				cell.Text = contents[i].FormatValue(value)
+			}
+			if contents[i].ValueType == models.OtenkiValueWeatherCode {
+				cell.Class = "weather"
+			}
+			row = append(row, cell)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	doc.Sections = []docSection{{Table: table}}
+	return doc
+}
+
+// pressureAnalysisDocument は気圧変化の分析結果を文書に変換します。hourly が true の場合は1時間ごとの変化量の節も含めます。
+func pressureAnalysisDocument(data analysis.PressureAnalysis, hourly bool) document {
+	doc := document{Title: i18n.Sprintf("%sの気圧変化分析", i18n.PlaceName(data.PlaceName))}
+	if len(data.Hours) == 0 {
+		doc.Sections = []docSection{{Notes: []string{i18n.T("分析できる気圧データがありません。")}}}
+		return doc
+	}
+
+	summary := docSection{Heading: i18n.Sprintf("最も急な気圧低下 (%d時間)", data.DropWindow)}
+	if drop := data.SteepestDrop; drop != nil {
+		summary.Notes = []string{fmt.Sprintf("%s 〜 %s: %.1f → %.1f hPa (%+.1f hPa, %+.2f hPa/h)",
+			drop.Start.Format("01/02 15:04"), drop.End.Format("01/02 15:04"), drop.StartPressure, drop.EndPressure, drop.Change, drop.RatePerHour)}
+	} else {
+		summary.Notes = []string{i18n.T("気圧が低下する時間帯はありません。")}
+	}
+
+	risk := docSection{Heading: i18n.Sprintf("リスク時間帯 (気圧レベルが%s以上、または3時間で%.1f hPa以上の低下)", data.RiskLevel.String(), data.DropThreshold)}
+	if len(data.RiskWindows) == 0 {
+		risk.Notes = []string{i18n.T("該当する時間帯はありません。")}
+	} else {
+		risk.Table = &docTable{Header: []string{i18n.T("開始"), i18n.T("終了"), i18n.T("時間数"), i18n.T("最大レベル"), i18n.T("3時間の最大低下"), i18n.T("変化量")}}
+		for _, window := range data.RiskWindows {
+			row := cells(window.Start.Format("01/02 15:04"), window.End.Format("01/02 15:04"), strconv.Itoa(window.Hours))
+			row = append(row, levelCell(window.MaxLevel))
+			row = append(row, cells(fmt.Sprintf("%.1f hPa", window.MaxDrop3h), fmt.Sprintf("%+.1f hPa", window.Change))...)
+			risk.Table.Rows = append(risk.Table.Rows, row)
+		}
+	}
+	doc.Sections = []docSection{summary, risk}
+
+	if hourly {
+		section := docSection{Heading: i18n.T("1時間ごとの気圧変化"), Table: &docTable{Header: []string{i18n.T("日時"), i18n.T("気圧"), i18n.T("1時間"), i18n.T("3時間"), i18n.T("6時間"), i18n.T("24時間"), i18n.T("気圧レベル")}}}
+		for _, h := range data.Hours {
+			row := cells(h.At.Format("01/02 15:04"), fmt.Sprintf("%.1f", h.Pressure),
+				formatChange(h.Delta), formatChange(h.Change3h), formatChange(h.Change6h), formatChange(h.Change24h))
+			section.Table.Rows = append(section.Table.Rows, append(row, levelCell(h.PressureLevel)))
+		}
+		doc.Sections = append(doc.Sections, section)
+	}
+	return doc
+}
@@ -0,0 +1,95 @@
+package presenter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/chart"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// graphHeight は気圧グラフの高さ (行数) です。
+const graphHeight = 12
+
+// PresentWeatherGraph は dayOffsets の日の気圧の推移を折れ線グラフで表示します。temperature が true の場合は気温も重ねて表示します。
+// 出力先が端末の場合は端末の幅に合わせて点字文字で、端末以外 (パイプやファイル) の場合は ASCII 文字で描画します。
+// 色を付ける場合 (Color を参照) は、気圧の線を気圧レベルに応じたテーマの色で描画します。
+// 表示する日が連続していない場合、日の間の線はつなぎません。
+func (p *TablePresenter) PresentWeatherGraph(data models.GetWeatherStatusResponse, dayOffsets []int, temperature bool) error {
+	w := p.ensureWriter()
+	theme := p.theme()
+	fmt.Fprintf(w, i18n.T("<%s|%s>の気圧グラフ\n"), i18n.PlaceName(data.PlaceName), data.PlaceID)
+
+	var pressures, temps []float64
+	var colors, labels []string
+	for i, dayOffset := range dayOffsets {
+		dayData, ok := data.ByDayOffset(dayOffset)
+		if !ok {
+			return i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+		}
+		if i > 0 && dayOffset != dayOffsets[i-1]+1 && len(pressures) > 0 {
+			pressures, temps = append(pressures, math.NaN()), append(temps, math.NaN())
+			colors, labels = append(colors, ""), append(labels, "")
+		}
+		for _, byTime := range dayData {
+			pressures = append(pressures, byTime.Pressure)
+			temp := math.NaN()
+			if byTime.Temp != nil {
+				temp = *byTime.Temp
+			}
+			temps = append(temps, temp)
+			colors = append(colors, theme.Levels[byTime.PressureLevel])
+			label := ""
+			switch {
+			case byTime.Hour == 0:
+				label = byTime.At.Format("01/02")
+			case byTime.Hour%6 == 0:
+				label = byTime.At.Format("15:04")
+			}
+			labels = append(labels, label)
+		}
+	}
+	if len(pressures) == 0 {
+		fmt.Fprintln(w, i18n.T("表示するデータがありません。"))
+		return nil
+	}
+
+	terminal := isTerminal(w)
+	// 描画領域の幅は、左の気圧の目盛り (例: "1013.2┤") と右の気温の目盛り (例: " 22.5") の分を除いた幅
+	width := terminalWidth(w) - 8
+	series := []chart.Series{{Name: i18n.T("気圧 (hPa)"), Values: pressures, Colors: colors}}
+	if temperature {
+		width -= 6
+		series = append(series, chart.Series{Name: i18n.T("気温 (℃)"), Values: temps, Color: theme.Accent})
+	}
+	opts := chart.Options{Width: max(width, 20), Height: graphHeight, Braille: terminal, Color: p.colorEnabled(), XLabels: labels}
+
+	fmt.Fprintln(w, graphLegend(series, opts, theme))
+	for _, line := range chart.Render(opts, series...) {
+		fmt.Fprintln(w, line)
+	}
+	return nil
+}
+
+// graphLegend は気圧グラフの凡例を返します。色を付ける場合は気圧レベルの色の凡例も含めます。
+func graphLegend(series []chart.Series, opts chart.Options, theme Theme) string {
+	var legend []string
+	for i, s := range series {
+		switch {
+		case !opts.Braille:
+			legend = append(legend, fmt.Sprintf("%c %s", []rune("*+")[i%2], s.Name))
+		case i == 0:
+			legend = append(legend, s.Name+i18n.T(" (左)"))
+		default:
+			legend = append(legend, colorize(s.Color, opts.Color, "─")+" "+s.Name+i18n.T(" (右)"))
+		}
+	}
+	if opts.Color {
+		for _, level := range []models.PressureLevelEnum{models.Normal, models.SlightAlert, models.Caution, models.Alert, models.SevereAlert} {
+			legend = append(legend, colorize(theme.Levels[level], true, "■")+" "+level.String())
+		}
+	}
+	return strings.Join(legend, "  ")
+}
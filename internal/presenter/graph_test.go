@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// testGraphWeatherStatus は今日と明後日の24時間分のデータを持つ気象状況です。
+func testGraphWeatherStatus() models.GetWeatherStatusResponse {
+	today := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{PlaceName: "渋谷区", PlaceID: "113", DateTime: models.APIDateTime{Time: today}}
+	for h := 0; h < 24; h++ {
+		level := models.Normal
+		if h >= 12 {
+			level = models.Alert
+		}
+		data.Today = append(data.Today, models.WeatherStatusByTime{
+			Hour: h, Weather: models.Sunny, Temp: models.NewFloat64(15 + float64(h)/2), Pressure: 1012 - float64(h)/4,
+			PressureLevel: level, At: today.Add(time.Duration(h) * time.Hour),
+		})
+		data.DayAfterTomorrow = append(data.DayAfterTomorrow, models.WeatherStatusByTime{
+			Hour: h, Weather: models.Cloudy, Pressure: 1006 + float64(h)/4,
+			PressureLevel: models.Normal, At: today.AddDate(0, 0, 2).Add(time.Duration(h) * time.Hour),
+		})
+	}
+	return data
+}
+
+func TestTablePresenterWeatherGraph(t *testing.T) {
+	t.Setenv("COLUMNS", "60")
+	var buf bytes.Buffer
+	require.NoError(t, (&TablePresenter{Writer: &buf}).PresentWeatherGraph(testGraphWeatherStatus(), []int{0, 2}, true))
+
+	output := buf.String()
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	assert.Equal(t, "<渋谷区|113>の気圧グラフ", lines[0])
+	assert.Equal(t, "* 気圧 (hPa)  + 気温 (℃)", lines[1], "端末以外への出力では ASCII 文字の凡例になるはずです")
+	assert.Len(t, lines, 2+graphHeight+2, "タイトル・凡例・グラフ・x 軸・x 軸の目盛りが出力されるはずです")
+	assert.NotContains(t, output, "\x1b[", "端末以外への出力では色を付けないはずです")
+	assert.True(t, strings.HasPrefix(lines[2], "1012.0+"), "左側に気圧の最大値の目盛りが表示されるはずです: %q", lines[2])
+	assert.True(t, strings.HasSuffix(lines[2], " 26.5"), "右側に気温の最大値の目盛りが表示されるはずです: %q", lines[2])
+	assert.Contains(t, lines[len(lines)-1], "05/20", "x 軸に日付の目盛りが表示されるはずです")
+	for _, line := range lines[2:] {
+		assert.LessOrEqual(t, utf8.RuneCountInString(line), 60, "端末の幅 (COLUMNS) に収まるはずです: %q", line)
+	}
+}
+
+func TestTablePresenterWeatherGraphNoData(t *testing.T) {
+	var buf bytes.Buffer
+	data := models.GetWeatherStatusResponse{PlaceName: "渋谷区", PlaceID: "113"}
+	require.NoError(t, (&TablePresenter{Writer: &buf}).PresentWeatherGraph(data, []int{0}, false))
+	assert.Equal(t, "<渋谷区|113>の気圧グラフ\n表示するデータがありません。\n", buf.String())
+
+	err := (&TablePresenter{Writer: &buf}).PresentWeatherGraph(data, []int{3}, false)
+	assert.Error(t, err, "無効な日付オフセットはエラーになるはずです")
+}
@@ -0,0 +1,113 @@
+package presenter
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// htmlPage は HTMLPresenter が出力するページのテンプレートです。
+// 外部のファイルを参照しないよう、CSS はページ内に埋め込みます。気圧レベルのセルは level-<コード> のクラスで色分けします。
+var htmlPage = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, -apple-system, "Hiragino Sans", "Noto Sans JP", sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.5em; border-bottom: 2px solid #4a7bd0; padding-bottom: 0.2em; }
+h2 { font-size: 1.2em; margin-top: 1.5em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.8em; text-align: center; }
+th { background: #eef3fb; }
+td.weather { font-size: 1.4em; }
+td.level-0 { background: #ffffff; }
+td.level-2 { background: #fff6bf; }
+td.level-3 { background: #ffd59e; }
+td.level-4 { background: #ff9e9e; }
+td.level-5 { background: #d9534f; color: #fff; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- range .Sections}}
+{{- if .Heading}}
+<h2>{{.Heading}}</h2>
+{{- end}}
+{{- range .Notes}}
+<p>{{.}}</p>
+{{- end}}
+{{- with .Table}}
+<table>
+<thead><tr>{{range .Header}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td{{if .Class}} class="{{.Class}}"{{end}}>{{.Text}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// HTMLPresenter は Presenter インターフェースを実装し、データを1つの HTML ページとして出力します。
+// CSS はページに埋め込まれるため、出力をそのままファイルに保存したりメールに添付したりできます。
+type HTMLPresenter struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+}
+
+func (p *HTMLPresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// render は文書を HTML ページとして出力するヘルパーメソッドです。
+func (p *HTMLPresenter) render(doc document) error {
+	doc.Lang = string(i18n.Current())
+	if err := htmlPage.Execute(p.ensureWriter(), doc); err != nil {
+		return i18n.Errorf("HTML出力の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// PresentPainStatus は痛み予報を表で出力します。
+func (p *HTMLPresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return p.render(painStatusDocument(data))
+}
+
+// PresentWeatherPoint は地点検索結果を表で出力します。
+func (p *HTMLPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	return p.render(weatherPointDocument(data, kata, keyword))
+}
+
+// PresentWeatherStatus は dayOffsets の日の気象状況を、1日1つの表 (1時間1行) で出力します。
+func (p *HTMLPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	doc, err := weatherStatusDocument(data, dayOffsets)
+	if err != nil {
+		return err
+	}
+	return p.render(doc)
+}
+
+// PresentOtenkiASP は targetDates の日の Otenki ASP データを1日1行の表で出力します。
+func (p *HTMLPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	return p.render(otenkiASPDocument(data, targetDates, cityName, cityCode))
+}
+
+// PresentPressureAnalysis は気圧変化の分析結果を出力します。hourly が true の場合は1時間ごとの変化量の表も出力します。
+func (p *HTMLPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return p.render(pressureAnalysisDocument(data, hourly))
+}
+
+// コンパイル時チェック: HTMLPresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*HTMLPresenter)(nil)
@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+)
+
+func TestHTMLPresenterWeatherStatus(t *testing.T) {
+	data := testWeatherStatus()
+	data.PlaceName = "<渋谷区>"
+
+	var buf bytes.Buffer
+	require.NoError(t, (&HTMLPresenter{Writer: &buf}).PresentWeatherStatus(data, []int{1}))
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	assert.Contains(t, out, `<html lang="ja">`)
+	assert.Contains(t, out, "<style>", "CSS はページに埋め込まれるはずです")
+	assert.Contains(t, out, "<h1>&lt;渋谷区&gt;の気圧予報</h1>", "文字列はエスケープされるはずです")
+	assert.Contains(t, out, `<td class="weather">☔</td>`)
+	assert.Contains(t, out, `<td class="level-5">厳重警戒</td>`, "気圧レベルはクラスで色分けされるはずです")
+}
+
+func TestHTMLPresenterLang(t *testing.T) {
+	i18n.SetLang(i18n.En)
+	t.Cleanup(func() { i18n.SetLang(i18n.Ja) })
+
+	var buf bytes.Buffer
+	require.NoError(t, (&HTMLPresenter{Writer: &buf}).PresentWeatherStatus(testWeatherStatus(), []int{1}))
+	out := buf.String()
+	assert.Contains(t, out, `<html lang="en">`, "英語表示の場合は lang 属性も英語のはずです")
+	assert.Contains(t, out, `<td class="level-5">Severe alert</td>`)
+}
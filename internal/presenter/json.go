@@ -6,6 +6,8 @@ import (
 	"io"
 	"os"
 	"time"
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 )
 
@@ -25,11 +27,11 @@ func (p *JSONPresenter) ensureWriter() io.Writer {
 func (p *JSONPresenter) marshalAndPrint(data interface{}) error {
 	jsonBytes, err := json.MarshalIndent(data, "", "    ")
 	if err != nil {
-		return fmt.Errorf("データをJSONにマーシャリングできませんでした: %w", err)
+		return i18n.Errorf("データをJSONにマーシャリングできませんでした: %w", err)
 	}
 	_, err = fmt.Fprintln(p.ensureWriter(), string(jsonBytes))
 	if err != nil {
-		return fmt.Errorf("JSON出力の書き込みに失敗しました: %w", err)
+		return i18n.Errorf("JSON出力の書き込みに失敗しました: %w", err)
 	}
 	return nil
 }
@@ -46,8 +48,8 @@ func (p *JSONPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse,
 }
 
 // PresentWeatherStatus は気象状況データをJSON形式で出力します。
-// dayOffset および dayName パラメータはJSON出力では無視されます。
-func (p *JSONPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffset int, dayName string) error {
+// dayOffsets パラメータはJSON出力では無視され、レスポンス全体が1回だけ出力されます。
+func (p *JSONPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
 	return p.marshalAndPrint(data)
 }
 
@@ -57,5 +59,11 @@ func (p *JSONPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targe
 	return p.marshalAndPrint(data)
 }
 
+// PresentPressureAnalysis は気圧変化の分析結果をJSON形式で出力します。
+// hourly パラメータはJSON出力では無視され、1時間ごとの変化量も常に出力されます。
+func (p *JSONPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return p.marshalAndPrint(data)
+}
+
 // コンパイル時チェック: JSONPresenter が Presenter インターフェースを実装していることを保証します。
 var _ Presenter = (*JSONPresenter)(nil)
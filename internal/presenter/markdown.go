@@ -0,0 +1,97 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// MarkdownPresenter は Presenter インターフェースを実装し、データを GitHub Flavored Markdown の見出しと表で出力します。
+// Wiki やメールにそのまま貼り付けることを想定しています。
+type MarkdownPresenter struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+}
+
+func (p *MarkdownPresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// render は文書を Markdown で出力するヘルパーメソッドです。
+func (p *MarkdownPresenter) render(doc document) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n", doc.Title)
+	for _, section := range doc.Sections {
+		if section.Heading != "" {
+			fmt.Fprintf(&b, "\n### %s\n", section.Heading)
+		}
+		for _, note := range section.Notes {
+			fmt.Fprintf(&b, "\n%s\n", note)
+		}
+		if table := section.Table; table != nil {
+			b.WriteString("\n| " + strings.Join(escapeMarkdownCells(table.Header), " | ") + " |\n")
+			b.WriteString("|" + strings.Repeat(" --- |", len(table.Header)) + "\n")
+			for _, row := range table.Rows {
+				texts := make([]string, len(row))
+				for i, cell := range row {
+					texts[i] = cell.Text
+				}
+				b.WriteString("| " + strings.Join(escapeMarkdownCells(texts), " | ") + " |\n")
+			}
+		}
+	}
+	if _, err := io.WriteString(p.ensureWriter(), b.String()); err != nil {
+		return i18n.Errorf("Markdown出力の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// escapeMarkdownCells は表のセルで特別な意味を持つ "|" をエスケープし、改行を <br> に置き換えます。
+func escapeMarkdownCells(texts []string) []string {
+	escaped := make([]string, len(texts))
+	for i, text := range texts {
+		text = strings.ReplaceAll(text, "|", `\|`)
+		escaped[i] = strings.ReplaceAll(text, "\n", "<br>")
+	}
+	return escaped
+}
+
+// PresentPainStatus は痛み予報を表で出力します。
+func (p *MarkdownPresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return p.render(painStatusDocument(data))
+}
+
+// PresentWeatherPoint は地点検索結果を表で出力します。
+func (p *MarkdownPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	return p.render(weatherPointDocument(data, kata, keyword))
+}
+
+// PresentWeatherStatus は dayOffsets の日の気象状況を、1日1つの表 (1時間1行) で出力します。
+func (p *MarkdownPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	doc, err := weatherStatusDocument(data, dayOffsets)
+	if err != nil {
+		return err
+	}
+	return p.render(doc)
+}
+
+// PresentOtenkiASP は targetDates の日の Otenki ASP データを1日1行の表で出力します。
+func (p *MarkdownPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	return p.render(otenkiASPDocument(data, targetDates, cityName, cityCode))
+}
+
+// PresentPressureAnalysis は気圧変化の分析結果を出力します。hourly が true の場合は1時間ごとの変化量の表も出力します。
+func (p *MarkdownPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return p.render(pressureAnalysisDocument(data, hourly))
+}
+
+// コンパイル時チェック: MarkdownPresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*MarkdownPresenter)(nil)
@@ -0,0 +1,54 @@
+package presenter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// testWeatherStatus は明日の2時間分のデータを持つ気象状況です。
+func testWeatherStatus() models.GetWeatherStatusResponse {
+	at := time.Date(2025, 5, 21, 9, 0, 0, 0, models.JST)
+	return models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		PlaceID:   "113",
+		DateTime:  models.APIDateTime{Time: time.Date(2025, 5, 20, 11, 0, 0, 0, models.JST)},
+		Tomorrow: []models.WeatherStatusByTime{
+			{Hour: 9, Weather: models.Rain, Temp: models.NewFloat64(18.5), Pressure: 1008.8, PressureLevel: models.Caution, At: at},
+			{Hour: 10, Weather: models.Cloudy, Pressure: 1008.4, PressureLevel: models.SevereAlert, At: at.Add(time.Hour)},
+		},
+	}
+}
+
+func TestMarkdownPresenterWeatherStatus(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&MarkdownPresenter{Writer: &buf}).PresentWeatherStatus(testWeatherStatus(), []int{0, 1}))
+	expected := `## 渋谷区の気圧予報
+
+### 今日 (2025-05-20)
+
+データがありません。
+
+### 明日 (2025-05-21)
+
+| 時刻 | 天気 | 気温 | 気圧 | 気圧レベル |
+| --- | --- | --- | --- | --- |
+| 9時 | ☔ | 18.5℃ | 1008.8 hPa | 注意 |
+| 10時 | ☁ | - | 1008.4 hPa | 厳重警戒 |
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestMarkdownPresenterEscape(t *testing.T) {
+	data := models.GetWeatherPointResponse{Result: models.WeatherPoints{Root: []models.WeatherPoint{{CityCode: "13113", Name: "渋谷|区"}}}}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&MarkdownPresenter{Writer: &buf}).PresentWeatherPoint(data, false, "渋谷"))
+	assert.True(t, strings.HasSuffix(buf.String(), "| 13113 | 渋谷\\|区 |\n"), "セル内の | はエスケープされるはずです: %s", buf.String())
+}
@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// NDJSONPresenter は Presenter インターフェースを実装し、データを1行1レコードの JSON (NDJSON) で出力します。
+// 入れ子の集約データを平坦なレコード (CSVPresenter と同じ列) に分解するため、jq -c やログ収集基盤でそのまま扱えます。
+type NDJSONPresenter struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+}
+
+func (p *NDJSONPresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// writeNDJSON は各レコードを1行の JSON として出力するヘルパー関数です。
+func writeNDJSON[T any](w io.Writer, records []T) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return i18n.Errorf("NDJSON出力の書き込みに失敗しました: %w", err)
+		}
+	}
+	return nil
+}
+
+// PresentPainStatus は痛み予報を1レコードで出力します。
+func (p *NDJSONPresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return writeNDJSON(p.ensureWriter(), []painStatusRecord{newPainStatusRecord(data)})
+}
+
+// PresentWeatherPoint は地点検索結果を1地点1レコードで出力します。
+// kata に関わらずカタカナ名も出力し、keyword は無視されます。
+func (p *NDJSONPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	return writeNDJSON(p.ensureWriter(), data.Result.Root)
+}
+
+// PresentWeatherStatus は dayOffsets の日の気象状況を1時間1レコードで出力します。
+func (p *NDJSONPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	records, err := weatherStatusRecords(data, dayOffsets)
+	if err != nil {
+		return err
+	}
+	return writeNDJSON(p.ensureWriter(), records)
+}
+
+// PresentOtenkiASP は targetDates の日の Otenki ASP データを1日1レコードで出力します。
+// キーにはコンテンツID (例: "day_tenki") を使い、値は変換せずに出力します。値が無い場合は null になります。
+func (p *NDJSONPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	return writeNDJSON(p.ensureWriter(), otenkiASPRecords(data, targetDates, cityName, cityCode))
+}
+
+// PresentPressureAnalysis は1時間ごとの気圧変化量を1時間1レコードで出力します。
+// hourly パラメータは無視されます。
+func (p *NDJSONPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return writeNDJSON(p.ensureWriter(), pressureHourRecords(data))
+}
+
+// コンパイル時チェック: NDJSONPresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*NDJSONPresenter)(nil)
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestNDJSONPresenterOtenkiASP(t *testing.T) {
+	day1 := time.Date(2025, 5, 20, 0, 0, 0, 0, models.JST)
+	day2 := day1.AddDate(0, 0, 1)
+	data := models.GetOtenkiASPResponse{Elements: []models.Element{
+		{ContentID: models.OtenkiContentWeather, Records: map[time.Time]interface{}{day1: "100", day2: "200"}},
+		{ContentID: models.OtenkiContentHighTemp, Records: map[time.Time]interface{}{day1: 24.5}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&NDJSONPresenter{Writer: &buf}).PresentOtenkiASP(data, []time.Time{day1, day2}, "東京", "13101"))
+	expected := `{"city_code":"13101","city_name":"東京","date":"2025-05-20","day_tenki":"100","hight_temp":24.5}` + "\n" +
+		`{"city_code":"13101","city_name":"東京","date":"2025-05-21","day_tenki":"200","hight_temp":null}` + "\n"
+	assert.Equal(t, expected, buf.String(), "1日1行で、コンテンツIDの順序が保たれるはずです")
+}
+
+func TestNDJSONPresenterWeatherStatus(t *testing.T) {
+	at := time.Date(2025, 5, 20, 9, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		PlaceID:   "113",
+		Today: []models.WeatherStatusByTime{
+			{Hour: 9, Weather: models.Sunny, Temp: models.NewFloat64(20.5), Pressure: 1012.2, PressureLevel: models.Normal, At: at},
+			{Hour: 10, Weather: models.Sunny, Pressure: 1011.9, PressureLevel: models.Normal, At: at.Add(time.Hour)},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&NDJSONPresenter{Writer: &buf}).PresentWeatherStatus(data, []int{0}))
+	expected := `{"place_id":"113","place_name":"渋谷区","day":"today","datetime":"2025-05-20T09:00:00+09:00","hour":9,"weather":"100","temp":20.5,"pressure":1012.2,"pressure_level":"0"}` + "\n" +
+		`{"place_id":"113","place_name":"渋谷区","day":"today","datetime":"2025-05-20T10:00:00+09:00","hour":10,"weather":"100","temp":null,"pressure":1011.9,"pressure_level":"0"}` + "\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestYAMLPresenterPainStatus(t *testing.T) {
+	data := models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{
+		AreaName: "東京都", TimeStart: "12", TimeEnd: "17", RateNormal: 46.5, RateLittle: 28.3, RatePainful: 17.1, RateBad: 8.1,
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&YAMLPresenter{Writer: &buf}).PresentPainStatus(data))
+	expected := `painnoterate_status:
+  area_name: 東京都
+  time_start: "12"
+  time_end: "17"
+  rate_0: 46.5
+  rate_1: 28.3
+  rate_2: 17.1
+  rate_3: 8.1
+`
+	assert.Equal(t, expected, buf.String(), "JSON と同じキー名と順序で、数値に見える文字列は引用符付きのはずです")
+}
@@ -1,8 +1,13 @@
 package presenter
 
 import (
+	"io"
+	"strings"
 	"time"
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/output"
 )
 
 // Presenter は API 結果を表示するためのインターフェースを定義します。
@@ -15,8 +20,85 @@ type Presenter interface {
 	PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error
 
 	// PresentWeatherStatus は詳細な気象状況を表示します。
-	PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffset int, dayName string) error
+	// dayOffsets には表示する日付オフセット (-1: 昨日 〜 2: 明後日) を昇順で渡します。
+	PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error
 
 	// PresentOtenkiASP は Otenki ASP の気象情報を表示します。
 	PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error
+
+	// PresentPressureAnalysis は気圧変化の分析結果を表示します。
+	// hourly が true の場合は1時間ごとの変化量も表示します。
+	PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error
+}
+
+// Formats は New で作成できる出力形式の一覧です。
+var Formats = output.Formats
+
+// options は New のオプションです。
+type options struct {
+	template string
+	color    string
+	theme    string
+}
+
+// Option は New のオプションを設定する関数です。
+type Option func(*options)
+
+// WithTemplate は出力形式 template で使用するテンプレート (ファイルのパスまたはテンプレート文字列) を設定します。
+func WithTemplate(spec string) Option {
+	return func(o *options) {
+		o.template = spec
+	}
+}
+
+// WithColor は出力形式 table で色を付けるかどうか (ColorModes のいずれか) を設定します。
+func WithColor(mode string) Option {
+	return func(o *options) {
+		o.color = mode
+	}
+}
+
+// WithTheme は出力形式 table で使用する色のテーマ (Themes の名前) を設定します。
+func WithTheme(name string) Option {
+	return func(o *options) {
+		o.theme = name
+	}
+}
+
+// New は出力形式 format に対応する Presenter を作成します。w は出力先です。
+func New(format string, w io.Writer, opts ...Option) (Presenter, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	switch format {
+	case "table", "":
+		color, err := ParseColorMode(o.color)
+		if err != nil {
+			return nil, err
+		}
+		theme, err := LookupTheme(o.theme)
+		if err != nil {
+			return nil, err
+		}
+		return &TablePresenter{Writer: w, Color: color, Theme: theme}, nil
+	case "json":
+		return &JSONPresenter{Writer: w}, nil
+	case "yaml":
+		return &YAMLPresenter{Writer: w}, nil
+	case "ndjson":
+		return &NDJSONPresenter{Writer: w}, nil
+	case "csv":
+		return &CSVPresenter{Writer: w}, nil
+	case "tsv":
+		return NewTSVPresenter(w), nil
+	case "markdown":
+		return &MarkdownPresenter{Writer: w}, nil
+	case "html":
+		return &HTMLPresenter{Writer: w}, nil
+	case "template":
+		return NewTemplatePresenter(w, o.template)
+	default:
+		return nil, i18n.Errorf("無効な出力形式です: %s (%s のいずれかを指定してください)", format, strings.Join(Formats, ", "))
+	}
 }
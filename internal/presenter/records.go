@@ -0,0 +1,244 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// このファイルは CSV や NDJSON など、入れ子の無い1行1レコードの出力形式で共通に使うレコードを定義します。
+// 各レコードの列名 (ヘッダー) は JSON のキー名と同じです。
+
+// painStatusHeader は painStatusRecord の列名です。
+var painStatusHeader = []string{"area_name", "time_start", "time_end", "rate_normal", "rate_little", "rate_painful", "rate_bad"}
+
+// painStatusRecord は痛み予報の1レコードです。
+type painStatusRecord struct {
+	AreaName    string  `json:"area_name"`
+	TimeStart   string  `json:"time_start"`
+	TimeEnd     string  `json:"time_end"`
+	RateNormal  float64 `json:"rate_normal"`
+	RateLittle  float64 `json:"rate_little"`
+	RatePainful float64 `json:"rate_painful"`
+	RateBad     float64 `json:"rate_bad"`
+}
+
+func newPainStatusRecord(data models.GetPainStatusResponse) painStatusRecord {
+	status := data.PainnoterateStatus
+	return painStatusRecord{
+		AreaName: status.AreaName, TimeStart: status.TimeStart, TimeEnd: status.TimeEnd,
+		RateNormal: status.RateNormal, RateLittle: status.RateLittle, RatePainful: status.RatePainful, RateBad: status.RateBad,
+	}
+}
+
+func (r painStatusRecord) values() []string {
+	return []string{
+		r.AreaName, r.TimeStart, r.TimeEnd,
+		formatFloat(r.RateNormal), formatFloat(r.RateLittle), formatFloat(r.RatePainful), formatFloat(r.RateBad),
+	}
+}
+
+// weatherPointHeader は地点検索結果 (models.WeatherPoint) の列名です。
+var weatherPointHeader = []string{"city_code", "name", "name_kata"}
+
+func weatherPointValues(point models.WeatherPoint) []string {
+	return []string{point.CityCode, point.Name, point.NameKata}
+}
+
+// weatherStatusHeader は weatherStatusRecord の列名です。
+var weatherStatusHeader = []string{"place_id", "place_name", "day", "datetime", "hour", "weather", "temp", "pressure", "pressure_level"}
+
+// weatherStatusRecord は気象状況の1時間分のレコードです。
+type weatherStatusRecord struct {
+	PlaceID       string                   `json:"place_id"`
+	PlaceName     string                   `json:"place_name"`
+	Day           string                   `json:"day"` // 日の名前 (例: "today")
+	DateTime      time.Time                `json:"datetime"`
+	Hour          int                      `json:"hour"`
+	Weather       models.WeatherEnum       `json:"weather"`
+	Temp          *float64                 `json:"temp"`
+	Pressure      float64                  `json:"pressure"`
+	PressureLevel models.PressureLevelEnum `json:"pressure_level"`
+}
+
+// weatherStatusRecords は dayOffsets の日の気象状況を1時間1レコードに変換します。
+func weatherStatusRecords(data models.GetWeatherStatusResponse, dayOffsets []int) ([]weatherStatusRecord, error) {
+	var records []weatherStatusRecord
+	for _, dayOffset := range dayOffsets {
+		dayName, ok := models.WeatherStatusDayName(dayOffset)
+		if !ok {
+			return nil, i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+		}
+		dayData, _ := data.ByDayOffset(dayOffset)
+		for _, byTime := range dayData {
+			records = append(records, weatherStatusRecord{
+				PlaceID: data.PlaceID, PlaceName: data.PlaceName, Day: dayName, DateTime: byTime.At, Hour: byTime.Hour,
+				Weather: byTime.Weather, Temp: byTime.Temp, Pressure: byTime.Pressure, PressureLevel: byTime.PressureLevel,
+			})
+		}
+	}
+	return records, nil
+}
+
+func (r weatherStatusRecord) values() []string {
+	return []string{
+		r.PlaceID, r.PlaceName, r.Day, formatTime(r.DateTime), strconv.Itoa(r.Hour),
+		string(r.Weather), formatOptionalFloat(r.Temp), formatFloat(r.Pressure), string(r.PressureLevel),
+	}
+}
+
+// otenkiASPRecord は Otenki ASP データの1日分のレコードです。
+// 列はコンテンツごとに異なるため、固定の列 (city_code, city_name, date) の後にコンテンツID (例: "day_tenki") の列が続きます。
+type otenkiASPRecord struct {
+	CityCode   string
+	CityName   string
+	Date       time.Time
+	ContentIDs []string
+	Values     []interface{} // ContentIDs と同じ順序の値。値が無い場合は nil
+}
+
+// otenkiASPHeader は data の Otenki ASP レコードの列名を返します。
+func otenkiASPHeader(data models.GetOtenkiASPResponse) []string {
+	header := []string{"city_code", "city_name", "date"}
+	for _, element := range data.Elements {
+		header = append(header, element.ContentID)
+	}
+	return header
+}
+
+// otenkiASPRecords は targetDates の日の Otenki ASP データを1日1レコードに変換します。
+func otenkiASPRecords(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) []otenkiASPRecord {
+	contentIDs := make([]string, len(data.Elements))
+	for i, element := range data.Elements {
+		contentIDs[i] = element.ContentID
+	}
+	records := make([]otenkiASPRecord, 0, len(targetDates))
+	for _, targetDate := range targetDates {
+		record := otenkiASPRecord{CityCode: cityCode, CityName: cityName, Date: targetDate, ContentIDs: contentIDs}
+		for _, element := range data.Elements {
+			record.Values = append(record.Values, element.Records[targetDate])
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (r otenkiASPRecord) values() []string {
+	values := []string{r.CityCode, r.CityName, r.Date.Format(time.DateOnly)}
+	for _, value := range r.Values {
+		values = append(values, formatRecordValue(value))
+	}
+	return values
+}
+
+// MarshalJSON は列の順序を保ったまま、コンテンツIDをキーとする1つのオブジェクトとしてレコードを出力します。
+func (r otenkiASPRecord) MarshalJSON() ([]byte, error) {
+	keys := append([]string{"city_code", "city_name", "date"}, r.ContentIDs...)
+	values := append([]interface{}{r.CityCode, r.CityName, r.Date.Format(time.DateOnly)}, r.Values...)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// pressureHourHeader は pressureHourRecord の列名です。
+var pressureHourHeader = []string{"place_id", "place_name", "datetime", "pressure", "pressure_level", "delta", "change_3h", "change_6h", "change_24h", "risk"}
+
+// pressureHourRecord は気圧変化の分析結果の1時間分のレコードです。
+type pressureHourRecord struct {
+	PlaceID       string                   `json:"place_id"`
+	PlaceName     string                   `json:"place_name"`
+	DateTime      time.Time                `json:"datetime"`
+	Pressure      float64                  `json:"pressure"`
+	PressureLevel models.PressureLevelEnum `json:"pressure_level"`
+	Delta         *float64                 `json:"delta"`
+	Change3h      *float64                 `json:"change_3h"`
+	Change6h      *float64                 `json:"change_6h"`
+	Change24h     *float64                 `json:"change_24h"`
+	Risk          bool                     `json:"risk"` // リスク時間帯に含まれるか
+}
+
+// pressureHourRecords は1時間ごとの気圧変化量を1時間1レコードに変換します。
+func pressureHourRecords(data analysis.PressureAnalysis) []pressureHourRecord {
+	records := make([]pressureHourRecord, 0, len(data.Hours))
+	for _, h := range data.Hours {
+		risk := false
+		for _, window := range data.RiskWindows {
+			if !h.At.Before(window.Start) && !h.At.After(window.End) {
+				risk = true
+				break
+			}
+		}
+		records = append(records, pressureHourRecord{
+			PlaceID: data.PlaceID, PlaceName: data.PlaceName, DateTime: h.At, Pressure: h.Pressure, PressureLevel: h.PressureLevel,
+			Delta: h.Delta, Change3h: h.Change3h, Change6h: h.Change6h, Change24h: h.Change24h, Risk: risk,
+		})
+	}
+	return records
+}
+
+func (r pressureHourRecord) values() []string {
+	return []string{
+		r.PlaceID, r.PlaceName, formatTime(r.DateTime), formatFloat(r.Pressure), string(r.PressureLevel),
+		formatOptionalFloat(r.Delta), formatOptionalFloat(r.Change3h), formatOptionalFloat(r.Change6h), formatOptionalFloat(r.Change24h),
+		strconv.FormatBool(r.Risk),
+	}
+}
+
+// formatFloat は小数を必要な桁数だけの文字列に変換します (例: 1012.5, 46)。
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatOptionalFloat は nil の場合に空文字列を返す formatFloat です。
+func formatOptionalFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return formatFloat(*v)
+}
+
+// formatTime は時刻を RFC 3339 形式に変換します。ゼロ値の場合は空文字列を返します。
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatRecordValue は Otenki ASP の Records の値を文字列に変換します。nil の場合は空文字列を返します。
+func formatRecordValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return formatFloat(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -6,14 +6,21 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
 	"github.com/eraiza0816/zu2l/internal/models"
 
 	"github.com/olekukonko/tablewriter"
 )
 
+// TablePresenter は Presenter インターフェースを実装し、データを表形式で出力します。
+// 気圧レベルと最も多い痛み予報の区分は Theme の色で表示します。色を付けるかどうかは Color で指定します。
 type TablePresenter struct {
 	Writer io.Writer
+	Color  ColorMode // 色を付けるかどうか (空の場合は ColorAuto)
+	Theme  Theme     // 色のテーマ (ゼロ値の場合は DefaultTheme)
 }
 
 func (p *TablePresenter) ensureWriter() io.Writer {
@@ -23,24 +30,64 @@ func (p *TablePresenter) ensureWriter() io.Writer {
 	return p.Writer
 }
 
+// colorEnabled は出力に色を付けるかどうかを返します。
+func (p *TablePresenter) colorEnabled() bool {
+	return p.Color.Enabled(p.ensureWriter())
+}
+
+// theme は使用するテーマを返します。
+func (p *TablePresenter) theme() Theme {
+	if p.Theme.Levels == nil {
+		return Themes[DefaultTheme]
+	}
+	return p.Theme
+}
+
+// levelText は text を気圧レベル level の色で表示する文字列を返します。色を付けない場合は text をそのまま返します。
+func (p *TablePresenter) levelText(level models.PressureLevelEnum, text string) string {
+	return colorize(p.theme().Levels[level], p.colorEnabled(), text)
+}
+
 func (p *TablePresenter) newTable() *tablewriter.Table {
 	writer := p.ensureWriter()
 	table := tablewriter.NewWriter(writer)
 	return table
 }
 
+// newHeaderTable はヘッダーを自動整形しない (例: "3時間" を "3 時間" にしない) テーブルを作成します。
+func (p *TablePresenter) newHeaderTable(headers ...any) *tablewriter.Table {
+	table := p.newTable()
+	table.Configure(func(config *tablewriter.Config) {
+		config.Header.Formatting.AutoFormat = false
+	})
+	table.Header(headers...)
+	return table
+}
+
 func (p *TablePresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
 	status := data.PainnoterateStatus
 
 	table := p.newTable()
 
-	sicknessLabels := []string{"普通", "少し痛い", "痛い", "かなり痛い"}
+	sicknessLabels := []string{i18n.T("普通"), i18n.T("少し痛い"), i18n.T("痛い"), i18n.T("かなり痛い")}
 	rates := []float64{status.RateNormal, status.RateLittle, status.RatePainful, status.RateBad}
 
+	// 最も割合が多い区分 (同じ割合の場合は軽い方) をテーマの色で強調する
+	dominant := 0
+	for i, rate := range rates {
+		if rate > rates[dominant] {
+			dominant = i
+		}
+	}
+	colored := p.colorEnabled()
 	for i, label := range sicknessLabels {
 		rate := rates[i]
 
-		table.Append([]string{fmt.Sprintf("%s: %.0f%%", label, rate)})
+		cell := fmt.Sprintf("%s: %.0f%%", label, rate)
+		if i == dominant {
+			cell = colorize(p.theme().Pain[i], colored, cell)
+		}
+		table.Append([]string{cell})
 	}
 
 	table.Render()
@@ -49,18 +96,18 @@ func (p *TablePresenter) PresentPainStatus(data models.GetPainStatusResponse) er
 
 func (p *TablePresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
 	table := p.newTable()
-	headers := []string{"地域コード", "地域名"}
+	headers := []string{i18n.T("地域コード"), i18n.T("地域名")}
 	if kata {
-		headers = append(headers, "地域カナ")
+		headers = append(headers, i18n.T("地域カナ"))
 	}
 
 	if len(data.Result.Root) == 0 {
-		fmt.Fprintf(p.ensureWriter(), "「%s」に一致する地域が見つかりませんでした。\n", keyword)
+		fmt.Fprintf(p.ensureWriter(), i18n.T("「%s」に一致する地域が見つかりませんでした。\n"), keyword)
 		return nil
 	}
 
 	for _, weatherPoint := range data.Result.Root {
-		row := []string{weatherPoint.CityCode, weatherPoint.Name}
+		row := []string{weatherPoint.CityCode, i18n.PointName(weatherPoint.Name, weatherPoint.NameKata)}
 		if kata {
 			row = append(row, weatherPoint.NameKata)
 		}
@@ -70,128 +117,113 @@ func (p *TablePresenter) PresentWeatherPoint(data models.GetWeatherPointResponse
 	return nil
 }
 
-// renderWeatherStatusSubTable は気象状況の12時間分のセグメントを描画します。
+// renderWeatherStatusSubTable は気象状況の12時間分のセグメントを table に行として追加します。
+// 各セグメントは時刻・天気・気温・気圧・気圧レベルの5行で構成され、先頭列に行ラベルを持ちます。
 // prevPressure は前の12時間セグメントの最後の気圧を保持し、矢印表示に使用します。
 // 最後の気圧を返します。
 func (p *TablePresenter) renderWeatherStatusSubTable(
+	table *tablewriter.Table,
 	dayData []models.WeatherStatusByTime, // 表示する日の時間別データ
 	startHour int,                        // このセグメントの開始時間 (0 または 12)
-	prevPressure float64,                 // 前のセグメントの最後の気圧 (矢印表示用)
-	title string,                         // テーブルのタイトル (最初のセグメントのみ)
+	prevPressure float64,                 // 前のセグメントの最後の気圧 (矢印表示用、0 の場合は矢印なし)
+	label string,                         // セグメントの見出し (日付など)
 ) float64 {
-	table := p.newTable()
-	if title != "" {
-	}
-
-	var headers []string
 	dataLen := len(dayData)
 	if dataLen == 0 {
-		fmt.Fprintf(p.ensureWriter(), "データがありません (%d時台)\n", startHour)
+		fmt.Fprintf(p.ensureWriter(), i18n.T("データがありません (%d時台)\n"), startHour)
 		return prevPressure
 	}
 
-	numHours := min(12, dataLen)
-	for i := 0; i < numHours; i++ {
-		headers = append(headers, strconv.Itoa(startHour+i))
-	}
-
-	weathers := make([]string, numHours)
-	temps := make([]string, numHours)
-	pressures := make([]string, numHours)
-	pressureLevels := make([]string, numHours)
+	// 列数を揃えるため、12時間に満たないセグメントは空セルで埋める
+	hours := make([]string, 12)
+	weathers := make([]string, 12)
+	temps := make([]string, 12)
+	pressures := make([]string, 12)
+	pressureLevels := make([]string, 12)
 
+	numHours := min(12, dataLen)
 	lastPressure := prevPressure
 	for i := 0; i < numHours; i++ {
 		byTime := dayData[i]
+		hours[i] = strconv.Itoa(byTime.Hour)
 
-		weatherCodeInt, err := strconv.Atoi(string(byTime.Weather))
-		emoji := "?"
-		if err == nil {
-			simplifiedCode := (weatherCodeInt / 100) * 100
-			if e, ok := models.WeatherEmojiMap[simplifiedCode]; ok {
-				emoji = e
-			}
-		}
-		weathers[i] = emoji
+		weathers[i] = weatherEmoji(byTime.Weather)
 
 		if byTime.Temp != nil {
-			tempFloat, err := strconv.ParseFloat(*byTime.Temp, 64)
-			if err != nil {
-				temps[i] = "?℃"
-				fmt.Fprintf(os.Stderr, "警告: 温度 '%s' の数値変換に失敗しました: %v\n", *byTime.Temp, err)
-			} else {
-				temps[i] = fmt.Sprintf("%.1f℃", tempFloat)
-			}
+			temps[i] = fmt.Sprintf("%.1f℃", *byTime.Temp)
 		} else {
 			temps[i] = "-℃"
 		}
 
-		pressureFloat, err := strconv.ParseFloat(byTime.Pressure, 64)
-		if err != nil {
-			pressureFloat = 0
-			fmt.Fprintf(os.Stderr, "警告: 気圧 '%s' の数値変換に失敗しました: %v\n", byTime.Pressure, err)
-		}
-
 		var arrow string
-		if (lastPressure == 0 && i == 0 && startHour == 0) || err != nil {
+		if lastPressure == 0 && i == 0 {
 			arrow = "→"
-		} else if pressureFloat > lastPressure {
+		} else if byTime.Pressure > lastPressure {
 			arrow = "↗"
-		} else if pressureFloat < lastPressure {
+		} else if byTime.Pressure < lastPressure {
 			arrow = "↘"
 		} else {
 			arrow = "→"
 		}
-		pressures[i] = fmt.Sprintf("%s\n%.1f", arrow, pressureFloat)
-		lastPressure = pressureFloat
+		pressures[i] = fmt.Sprintf("%s\n%.1f", arrow, byTime.Pressure)
+		lastPressure = byTime.Pressure
 
-		pressureLevels[i] = string(byTime.PressureLevel)
+		pressureLevels[i] = p.levelText(byTime.PressureLevel, string(byTime.PressureLevel))
 	}
 
-	table.Append(weathers)
-	table.Append(temps)
-	table.Append(pressures)
-	table.Append(pressureLevels)
-	table.Render()
+	table.Append(append([]string{label}, hours...))
+	table.Append(append([]string{i18n.T("天気")}, weathers...))
+	table.Append(append([]string{i18n.T("気温")}, temps...))
+	table.Append(append([]string{i18n.T("気圧")}, pressures...))
+	table.Append(append([]string{i18n.T("気圧レベル")}, pressureLevels...))
 
 	return lastPressure
 }
 
-// PresentWeatherStatus は詳細な気象状況をテーブル形式 (12時間ごとのセグメント) で表示します。
-func (p *TablePresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffset int, dayName string) error {
-	var dayData []models.WeatherStatusByTime
-	switch dayName {
-	case "yesterday":
-		dayData = data.Yesterday
-	case "today":
-		dayData = data.Today
-	case "tomorrow":
-		dayData = data.Tomorrow
-	case "dayaftertomorrow":
-		dayData = data.DayAfterTomorrow
-	default:
-		return fmt.Errorf("無効な dayName が提供されました: %s", dayName)
-	}
+// PresentWeatherStatus は詳細な気象状況を1つのテーブル (日ごとに12時間ずつのセグメント) で表示します。
+// 連続する日を表示する場合、気圧の矢印は前日の最後の気圧から引き継がれます。
+func (p *TablePresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	fmt.Fprintf(p.ensureWriter(), i18n.T("<%s|%s>の気圧予報\n"), i18n.PlaceName(data.PlaceName), data.PlaceID)
 
-	if len(dayData) == 0 {
-		fmt.Fprintf(p.ensureWriter(), "%s のデータがありません。\n", dayName)
-		return nil
-	}
-	// データが24時間分ない場合に警告を表示
-	if len(dayData) < 24 {
-		fmt.Fprintf(p.ensureWriter(), "警告: %s のデータが24時間分ありません (%d時間分)。利用可能なデータを表示します。\n", dayName, len(dayData))
-	}
+	table := p.newTable()
+	rendered := false
+	prevPressure := 0.0
+	prevOffset := 0
+	for i, dayOffset := range dayOffsets {
+		dayName, ok := models.WeatherStatusDayName(dayOffset)
+		if !ok {
+			return i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+		}
+		dayData, _ := data.ByDayOffset(dayOffset)
 
-	displayDate := data.DateTime.AddDate(0, 0, dayOffset).Format("2006-01-02")
-	title := fmt.Sprintf("<%s|%s>の気圧予報\n%s = %s",
-		data.PlaceName, data.PlaceID, dayName, displayDate)
+		if len(dayData) == 0 {
+			fmt.Fprintf(p.ensureWriter(), i18n.T("%s のデータがありません。\n"), dayName)
+			continue
+		}
+		// データが24時間分ない場合に警告を表示
+		if len(dayData) < 24 {
+			fmt.Fprintf(p.ensureWriter(), i18n.T("警告: %s のデータが24時間分ありません (%d時間分)。利用可能なデータを表示します。\n"), dayName, len(dayData))
+		}
 
-	prevPressure := p.renderWeatherStatusSubTable(dayData[0:min(12, len(dayData))], 0, 0, title)
+		// 前日が表示されていない場合は矢印を引き継がない
+		if i == 0 || dayOffset != prevOffset+1 {
+			prevPressure = 0
+		}
+		prevOffset = dayOffset
 
-	if len(dayData) > 12 {
-		p.renderWeatherStatusSubTable(dayData[12:min(24, len(dayData))], 12, prevPressure, "")
+		displayDate := data.DateTime.AddDate(0, 0, dayOffset).Format("2006-01-02")
+		label := fmt.Sprintf("%s\n%s", dayName, displayDate)
+
+		prevPressure = p.renderWeatherStatusSubTable(table, dayData[0:min(12, len(dayData))], 0, prevPressure, label)
+		if len(dayData) > 12 {
+			prevPressure = p.renderWeatherStatusSubTable(table, dayData[12:min(24, len(dayData))], 12, prevPressure, "")
+		}
+		rendered = true
 	}
 
+	if rendered {
+		table.Render()
+	}
 	return nil
 }
 
@@ -199,7 +231,7 @@ func (p *TablePresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targ
 	table := p.newTable()
 
 	if len(data.Elements) == 0 {
-		fmt.Fprintf(p.ensureWriter(), "表示する天気情報要素がありません。\n")
+		fmt.Fprint(p.ensureWriter(), i18n.T("表示する天気情報要素がありません。\n"))
 		return nil
 	}
 
@@ -208,52 +240,31 @@ func (p *TablePresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targ
 	})
 
 	if len(targetDates) == 0 {
-		fmt.Fprintf(p.ensureWriter(), "表示対象の日付が指定されていません。\n")
+		fmt.Fprint(p.ensureWriter(), i18n.T("表示対象の日付が指定されていません。\n"))
 		return nil
 	}
 
 	// tablewriter のヘッダーではなく、理想的なヘッダー文字列を手動で出力
-	idealHeaderString := "日付\t天気\t降水確率\t最高気温\t最低気温\t最大風速\t最大風速時風向\t気圧予報レベル\t最小湿度"
-	fmt.Fprintln(p.ensureWriter(), idealHeaderString)
+	// 列の見出しと値の整形は models.OtenkiContents のコンテンツ定義に従う
+	contents := make([]models.OtenkiContent, len(data.Elements))
+	headers := []string{i18n.T("日付")}
+	for i, element := range data.Elements {
+		content, ok := models.LookupOtenkiContent(element.ContentID)
+		if !ok && element.Title != "" {
+			content.Title = element.Title
+		}
+		contents[i] = content
+		headers = append(headers, content.Header())
+	}
+	fmt.Fprintln(p.ensureWriter(), strings.Join(headers, "\t"))
 
 	for _, targetDate := range targetDates {
 		row := []string{targetDate.Format("01/02")}
 
-		for _, element := range data.Elements {
-			value, ok := element.Records[targetDate]
+		for i, element := range data.Elements {
 			valueStr := "-"
-			if ok {
-				switch v := value.(type) {
-				case string:
-					if element.ContentID == "day_tenki" {
-						weatherCodeInt, err := strconv.Atoi(v)
-						if err == nil {
-							simplifiedCode := (weatherCodeInt / 100) * 100
-							if emoji, okEmoji := models.WeatherEmojiMap[simplifiedCode]; okEmoji {
-								valueStr = emoji
-							} else {
-								valueStr = v
-							}
-						} else {
-							valueStr = v
-						}
-					} else {
-						valueStr = v
-					}
-				case float64:
-					if element.ContentID == "day_pre" || element.ContentID == "low_humidity" || element.ContentID == "zutu_level_day" || element.ContentID == "day_wind_d" {
-						if v == float64(int(v)) {
-							valueStr = strconv.Itoa(int(v))
-						} else {
-							valueStr = fmt.Sprintf("%.1f", v)
-						}
-					} else {
-						valueStr = fmt.Sprintf("%.1f", v)
-					}
-				default:
-					fmt.Fprintf(os.Stderr, "警告: ContentID '%s' の予期しない値の型: %T, 値: %v\n", element.ContentID, v, v)
-					valueStr = fmt.Sprintf("%v", v)
-				}
+			if value, ok := element.Records[targetDate]; ok {
+				valueStr = contents[i].FormatValue(value)
 			}
 			row = append(row, valueStr)
 		}
@@ -264,6 +275,69 @@ func (p *TablePresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targ
 	return nil
 }
 
+// PresentPressureAnalysis は気圧変化の分析結果を表示します。
+// 最も急な気圧低下とリスク時間帯を表示し、hourly が true の場合は1時間ごとの変化量のテーブルも表示します。
+func (p *TablePresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	w := p.ensureWriter()
+	fmt.Fprintf(w, i18n.T("<%s|%s>の気圧変化分析\n"), i18n.PlaceName(data.PlaceName), data.PlaceID)
+
+	if len(data.Hours) == 0 {
+		fmt.Fprintln(w, i18n.T("分析できる気圧データがありません。"))
+		return nil
+	}
+
+	if drop := data.SteepestDrop; drop != nil {
+		fmt.Fprintf(w, i18n.T("最も急な気圧低下 (%d時間): %s 〜 %s %.1f → %.1f hPa (%+.1f hPa, %+.2f hPa/h)\n"),
+			data.DropWindow, drop.Start.Format("01/02 15:04"), drop.End.Format("01/02 15:04"),
+			drop.StartPressure, drop.EndPressure, drop.Change, drop.RatePerHour)
+	} else {
+		fmt.Fprintf(w, i18n.T("最も急な気圧低下 (%d時間): 気圧が低下する時間帯はありません\n"), data.DropWindow)
+	}
+
+	fmt.Fprintf(w, i18n.T("リスク時間帯 (気圧レベルが%s以上、または3時間で%.1f hPa以上の低下):\n"), data.RiskLevel.String(), data.DropThreshold)
+	if len(data.RiskWindows) == 0 {
+		fmt.Fprintln(w, i18n.T("該当する時間帯はありません"))
+	} else {
+		table := p.newHeaderTable(i18n.T("開始"), i18n.T("終了"), i18n.T("時間数"), i18n.T("最大レベル"), i18n.T("3時間の最大低下"), i18n.T("変化量"))
+		for _, window := range data.RiskWindows {
+			table.Append([]string{
+				window.Start.Format("01/02 15:04"),
+				window.End.Format("01/02 15:04"),
+				strconv.Itoa(window.Hours),
+				p.levelText(window.MaxLevel, window.MaxLevel.String()),
+				fmt.Sprintf("%.1f", window.MaxDrop3h),
+				fmt.Sprintf("%+.1f", window.Change),
+			})
+		}
+		table.Render()
+	}
+
+	if hourly {
+		table := p.newHeaderTable(i18n.T("日時"), i18n.T("気圧"), i18n.T("1時間"), i18n.T("3時間"), i18n.T("6時間"), i18n.T("24時間"), i18n.T("気圧レベル"))
+		for _, h := range data.Hours {
+			table.Append([]string{
+				h.At.Format("01/02 15:04"),
+				fmt.Sprintf("%.1f", h.Pressure),
+				formatChange(h.Delta),
+				formatChange(h.Change3h),
+				formatChange(h.Change6h),
+				formatChange(h.Change24h),
+				p.levelText(h.PressureLevel, h.PressureLevel.String()),
+			})
+		}
+		table.Render()
+	}
+	return nil
+}
+
+// formatChange は気圧の変化量を符号付きで整形します。nil の場合は "-" を返します。
+func formatChange(change *float64) string {
+	if change == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f", *change)
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
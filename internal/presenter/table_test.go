@@ -0,0 +1,154 @@
+package presenter
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/output"
+)
+
+// update が指定された場合 (go test ./internal/presenter -update)、golden ファイルを現在の出力で更新します。
+var update = flag.Bool("update", false, "testdata の golden ファイルを更新する")
+
+// assertGolden は got が testdata/<name>.golden の内容と一致することを確認します。
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "golden ファイルがありません。-update で作成してください")
+	assert.Equal(t, string(want), string(got), "出力が %s と一致しません。意図した変更の場合は -update で更新してください", path)
+}
+
+func testPainStatus() models.GetPainStatusResponse {
+	return models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{
+		AreaName: "東京都", TimeStart: "12", TimeEnd: "15", RateNormal: 20, RateLittle: 25, RatePainful: 40, RateBad: 15,
+	}}
+}
+
+func testPressureAnalysis() analysis.PressureAnalysis {
+	at := time.Date(2025, 5, 21, 9, 0, 0, 0, models.JST)
+	delta := -0.4
+	return analysis.PressureAnalysis{
+		PlaceName: "渋谷区", PlaceID: "113", DropWindow: 6, DropThreshold: 2, RiskLevel: models.Caution,
+		Hours: []analysis.HourlyChange{
+			{At: at, Pressure: 1008.8, PressureLevel: models.Caution},
+			{At: at.Add(time.Hour), Pressure: 1008.4, PressureLevel: models.SevereAlert, Delta: &delta},
+		},
+		SteepestDrop: &analysis.DropWindow{Start: at, End: at.Add(time.Hour), StartPressure: 1008.8, EndPressure: 1008.4, Change: -0.4, RatePerHour: -0.4},
+		RiskWindows: []analysis.RiskWindow{
+			{Start: at, End: at.Add(time.Hour), Hours: 2, MaxLevel: models.SevereAlert, Change: -0.4},
+		},
+	}
+}
+
+func TestTablePresenterGolden(t *testing.T) {
+	tests := []struct {
+		name    string
+		color   ColorMode
+		theme   string
+		present func(p *TablePresenter) error
+	}{
+		{"pain_status", ColorNever, "", func(p *TablePresenter) error { return p.PresentPainStatus(testPainStatus()) }},
+		{"pain_status_color", ColorAlways, "", func(p *TablePresenter) error { return p.PresentPainStatus(testPainStatus()) }},
+		{"weather_status", ColorNever, "", func(p *TablePresenter) error { return p.PresentWeatherStatus(testWeatherStatus(), []int{1}) }},
+		{"weather_status_color", ColorAlways, "", func(p *TablePresenter) error { return p.PresentWeatherStatus(testWeatherStatus(), []int{1}) }},
+		{"weather_status_256", ColorAlways, "256", func(p *TablePresenter) error { return p.PresentWeatherStatus(testWeatherStatus(), []int{1}) }},
+		{"pressure_analysis", ColorNever, "", func(p *TablePresenter) error { return p.PresentPressureAnalysis(testPressureAnalysis(), true) }},
+		{"pressure_analysis_color", ColorAlways, "", func(p *TablePresenter) error { return p.PresentPressureAnalysis(testPressureAnalysis(), true) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme, err := LookupTheme(tt.theme)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, tt.present(&TablePresenter{Writer: &buf, Color: tt.color, Theme: theme}))
+			if tt.color == ColorNever {
+				assert.NotContains(t, buf.String(), "\x1b[", "色を付けない場合はエスケープシーケンスを含まないはずです")
+			}
+			assertGolden(t, tt.name, buf.Bytes())
+		})
+	}
+}
+
+func TestTablePresenterGoldenEnglish(t *testing.T) {
+	i18n.SetLang(i18n.En)
+	t.Cleanup(func() { i18n.SetLang(i18n.Ja) })
+
+	points := models.GetWeatherPointResponse{}
+	points.Result.Root = []models.WeatherPoint{{CityCode: "13113", Name: "東京都渋谷区", NameKata: "ｼﾌﾞﾔｸ"}}
+	tests := []struct {
+		name    string
+		present func(p *TablePresenter) error
+	}{
+		{"pain_status_en", func(p *TablePresenter) error { return p.PresentPainStatus(testPainStatus()) }},
+		{"weather_point_en", func(p *TablePresenter) error { return p.PresentWeatherPoint(points, true, "渋谷") }},
+		{"weather_status_en", func(p *TablePresenter) error { return p.PresentWeatherStatus(testWeatherStatus(), []int{1}) }},
+		{"pressure_analysis_en", func(p *TablePresenter) error { return p.PresentPressureAnalysis(testPressureAnalysis(), true) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, tt.present(&TablePresenter{Writer: &buf, Color: ColorNever}))
+			assertGolden(t, tt.name, buf.Bytes())
+		})
+	}
+}
+
+func TestColorModeEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	t.Setenv(NoColorEnv, "")
+	assert.False(t, ColorAuto.Enabled(&buf), "auto では端末以外に色を付けないはずです")
+	assert.True(t, ColorAlways.Enabled(&buf))
+	assert.False(t, ColorNever.Enabled(&buf))
+
+	t.Setenv(NoColorEnv, "1")
+	assert.True(t, ColorAlways.Enabled(&buf), "always は NO_COLOR より優先されるはずです")
+
+	var p TablePresenter
+	assert.Equal(t, Themes[DefaultTheme].Levels, p.theme().Levels, "テーマが指定されていない場合は default テーマを使うはずです")
+}
+
+func TestParseColorModeAndTheme(t *testing.T) {
+	mode, err := ParseColorMode("")
+	require.NoError(t, err)
+	assert.Equal(t, ColorAuto, mode)
+	mode, err = ParseColorMode("always")
+	require.NoError(t, err)
+	assert.Equal(t, ColorAlways, mode)
+	_, err = ParseColorMode("sometimes")
+	assert.Error(t, err)
+
+	theme, err := LookupTheme("mono")
+	require.NoError(t, err)
+	assert.Equal(t, "mono", theme.Name)
+	_, err = LookupTheme("rainbow")
+	assert.EqualError(t, err, "無効なテーマです: rainbow (256, default, mono のいずれかを指定してください)")
+
+	_, err = New("table", &bytes.Buffer{}, WithColor("sometimes"))
+	assert.Error(t, err, "無効な色の指定はエラーになるはずです")
+	p, err := New("table", &bytes.Buffer{}, WithColor("never"), WithTheme("256"))
+	require.NoError(t, err)
+	assert.Equal(t, ColorNever, p.(*TablePresenter).Color)
+	assert.Equal(t, "256", p.(*TablePresenter).Theme.Name)
+}
+
+func TestOutputNames(t *testing.T) {
+	assert.Equal(t, output.ThemeNames, ThemeNames(), "output.ThemeNames は組み込みのテーマの名前と一致するはずです")
+	for _, s := range output.ColorModes {
+		_, err := ParseColorMode(s)
+		assert.NoError(t, err, "output.ColorModes の %s は ColorMode として有効なはずです", s)
+	}
+}
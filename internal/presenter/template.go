@@ -0,0 +1,186 @@
+package presenter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+// TemplatePresenter は Presenter インターフェースを実装し、ユーザー定義の Go テンプレート (text/template) でデータを出力します。
+// テンプレートにはレスポンス (例: models.GetWeatherStatusResponse) がそのまま渡されるため、
+// {{.PlaceName}} や {{range .Today}} のように JSON と同じ構造を参照できます。使用できる関数は TemplateFuncs を参照してください。
+type TemplatePresenter struct {
+	Writer   io.Writer // 出力先 (nil の場合は os.Stdout)
+	Template *template.Template
+}
+
+// NewTemplatePresenter は spec のテンプレートで出力する TemplatePresenter を作成します。
+// spec に "{{" が含まれる場合はテンプレート文字列として、それ以外はテンプレートファイルのパスとして扱います。
+func NewTemplatePresenter(w io.Writer, spec string) (*TemplatePresenter, error) {
+	if spec == "" {
+		return nil, i18n.Errorf("テンプレートが指定されていません")
+	}
+	name, text := "template", spec
+	if !strings.Contains(spec, "{{") {
+		data, err := os.ReadFile(spec)
+		if err != nil {
+			return nil, i18n.Errorf("テンプレートファイルの読み込みに失敗しました: %w", err)
+		}
+		name, text = spec, string(data)
+	}
+	tmpl, err := template.New(name).Funcs(TemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, i18n.Errorf("テンプレートの解析に失敗しました: %w", err)
+	}
+	return &TemplatePresenter{Writer: w, Template: tmpl}, nil
+}
+
+func (p *TemplatePresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// execute はテンプレートを実行し、結果を出力するヘルパーメソッドです。
+// 途中でエラーになった場合に一部だけ出力しないよう、結果はバッファしてから出力します。末尾に改行が無い場合は追加します。
+func (p *TemplatePresenter) execute(data interface{}) error {
+	var buf bytes.Buffer
+	if err := p.Template.Execute(&buf, data); err != nil {
+		return i18n.Errorf("テンプレートの実行に失敗しました: %w", err)
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	if _, err := buf.WriteTo(p.ensureWriter()); err != nil {
+		return i18n.Errorf("テンプレート出力の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// PresentPainStatus は痛み予報データ (models.GetPainStatusResponse) をテンプレートで出力します。
+func (p *TemplatePresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return p.execute(data)
+}
+
+// PresentWeatherPoint は地点検索結果データ (models.GetWeatherPointResponse) をテンプレートで出力します。
+// kata および keyword パラメータは無視されます。
+func (p *TemplatePresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	return p.execute(data)
+}
+
+// PresentWeatherStatus は気象状況データ (models.GetWeatherStatusResponse) をテンプレートで出力します。
+// dayOffsets パラメータは無視されます。特定の日のデータは {{range day . 1}} のように day 関数で参照できます。
+func (p *TemplatePresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	return p.execute(data)
+}
+
+// PresentOtenkiASP は Otenki ASP データ (models.GetOtenkiASPResponse) をテンプレートで出力します。
+// targetDates, cityName, cityCode パラメータは無視されます。
+func (p *TemplatePresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	return p.execute(data)
+}
+
+// PresentPressureAnalysis は気圧変化の分析結果 (analysis.PressureAnalysis) をテンプレートで出力します。
+// hourly パラメータは無視されます。
+func (p *TemplatePresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return p.execute(data)
+}
+
+// TemplateFuncs は TemplatePresenter のテンプレートで使用できる関数です。
+//
+//	emoji    天気コードを絵文字に変換する ({{emoji .Weather}} → ☀)
+//	icon     天気コードを Nerd Font のアイコンに変換する ({{icon .Weather}})
+//	weather  天気コードを天気の名前に変換する ({{weather .Weather}} → 晴れ)
+//	level    気圧レベルを名前に変換する ({{level .PressureLevel}} → 警戒)
+//	fixed    数値を指定した小数点以下の桁数で整形する。nil の場合は "-" ({{fixed 1 .Temp}} → 15.3)
+//	signed   fixed と同じく整形し、符号を付ける ({{signed 1 .Change3h}} → -1.2)
+//	date     時刻を Go のレイアウトで整形する ({{date "01/02 15:04" .At}})
+//	addHours 時刻に時間を加える ({{addHours 3 .At}})
+//	addDays  時刻に日数を加える ({{addDays 1 now}})
+//	now      現在時刻 (日本時間) を返す
+//	day      気象状況の日付オフセット (-1: 昨日 〜 2: 明後日) の時間別データを返す ({{range day . 1}})
+//	join     文字列のスライスを区切り文字で連結する ({{join $names ", "}})
+var TemplateFuncs = template.FuncMap{
+	"emoji":    weatherEmoji,
+	"icon":     func(code interface{}) string { return models.WeatherEnum(codeString(code)).NerdIcon() },
+	"weather":  func(code interface{}) string { return models.WeatherEnum(codeString(code)).String() },
+	"level":    func(level interface{}) string { return models.PressureLevelEnum(codeString(level)).String() },
+	"fixed":    func(digits int, v interface{}) (string, error) { return formatNumber("%.*f", digits, v) },
+	"signed":   func(digits int, v interface{}) (string, error) { return formatNumber("%+.*f", digits, v) },
+	"date":     func(layout string, t time.Time) string { return t.Format(layout) },
+	"addHours": func(hours int, t time.Time) time.Time { return t.Add(time.Duration(hours) * time.Hour) },
+	"addDays":  func(days int, t time.Time) time.Time { return t.AddDate(0, 0, days) },
+	"now":      func() time.Time { return time.Now().In(models.JST) },
+	"day":      templateDay,
+	"join":     func(elems []string, sep string) string { return strings.Join(elems, sep) },
+}
+
+// codeString は天気コードや気圧レベル (WeatherEnum、PressureLevelEnum、文字列、数値) をコードの文字列に変換します。
+// WeatherEnum などは String() で名前に変換されるため、fmt.Sprint ではなく基底の文字列を使います。
+func codeString(code interface{}) string {
+	switch c := code.(type) {
+	case models.WeatherEnum:
+		return string(c)
+	case models.PressureLevelEnum:
+		return string(c)
+	default:
+		return fmt.Sprint(code)
+	}
+}
+
+// weatherEmoji は天気コード (WeatherEnum、文字列、数値) を絵文字に変換します。対応する絵文字が無い場合は "?" を返します。
+func weatherEmoji(code interface{}) string {
+	if emoji := models.WeatherEnum(codeString(code)).Emoji(); emoji != "" {
+		return emoji
+	}
+	return "?"
+}
+
+// formatNumber は数値 (float64, *float64, 整数, 数値の文字列) を format で整形します。nil の場合は "-" を返します。
+func formatNumber(format string, digits int, v interface{}) (string, error) {
+	var f float64
+	switch n := v.(type) {
+	case nil:
+		return "-", nil
+	case *float64:
+		if n == nil {
+			return "-", nil
+		}
+		f = *n
+	case float64:
+		f = n
+	case int:
+		f = float64(n)
+	case string:
+		parsed, err := strconv.ParseFloat(n, 64)
+		if err != nil {
+			return "", i18n.Errorf("数値ではありません: %q", n)
+		}
+		f = parsed
+	default:
+		return "", i18n.Errorf("数値ではありません: %v", v)
+	}
+	return fmt.Sprintf(format, digits, f), nil
+}
+
+// templateDay は気象状況の日付オフセットに対応する時間別データを返します。
+func templateDay(data models.GetWeatherStatusResponse, dayOffset int) ([]models.WeatherStatusByTime, error) {
+	dayData, ok := data.ByDayOffset(dayOffset)
+	if !ok {
+		return nil, i18n.Errorf("無効な日付オフセットが提供されました: %d", dayOffset)
+	}
+	return dayData, nil
+}
+
+// コンパイル時チェック: TemplatePresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*TemplatePresenter)(nil)
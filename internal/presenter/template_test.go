@@ -0,0 +1,66 @@
+package presenter
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eraiza0816/zu2l/internal/models"
+)
+
+func TestTemplatePresenterWeatherStatus(t *testing.T) {
+	at := time.Date(2025, 5, 21, 9, 0, 0, 0, models.JST)
+	data := models.GetWeatherStatusResponse{
+		PlaceName: "渋谷区",
+		Tomorrow: []models.WeatherStatusByTime{
+			{Hour: 9, Weather: models.Rain, Temp: models.NewFloat64(19.45), Pressure: 1008.8, PressureLevel: models.Alert, At: at},
+			{Hour: 10, Weather: "999", Pressure: 1008.4, PressureLevel: models.Normal, At: at.Add(time.Hour)},
+		},
+	}
+
+	var buf bytes.Buffer
+	p, err := NewTemplatePresenter(&buf, `{{.PlaceName}}:{{range day . 1}} {{date "15時" .At}}{{emoji .Weather}}{{fixed 1 .Temp}}/{{level .PressureLevel}}{{end}}`)
+	require.NoError(t, err)
+	require.NoError(t, p.PresentWeatherStatus(data, []int{1}))
+	assert.Equal(t, "渋谷区: 09時☔19.4/警戒 10時?-/通常\n", buf.String(), "末尾に改行が追加されるはずです")
+}
+
+func TestTemplatePresenterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pain.tmpl")
+	require.NoError(t, os.WriteFile(path, []byte("{{with .PainnoterateStatus}}{{.AreaName}} {{signed 1 .RateBad}}{{end}}\n"), 0o644))
+
+	var buf bytes.Buffer
+	p, err := NewTemplatePresenter(&buf, path)
+	require.NoError(t, err)
+	require.NoError(t, p.PresentPainStatus(models.GetPainStatusResponse{PainnoterateStatus: models.GetPainStatus{AreaName: "東京都", RateBad: 8.1}}))
+	assert.Equal(t, "東京都 +8.1\n", buf.String())
+}
+
+func TestTemplatePresenterErrors(t *testing.T) {
+	_, err := NewTemplatePresenter(nil, "")
+	assert.Error(t, err, "テンプレートが空の場合はエラーになるはずです")
+	_, err = NewTemplatePresenter(nil, filepath.Join(t.TempDir(), "missing.tmpl"))
+	assert.Error(t, err)
+	_, err = NewTemplatePresenter(nil, "{{.PlaceName")
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	p, err := NewTemplatePresenter(&buf, "{{.PlaceName}} {{range day . 5}}{{end}}")
+	require.NoError(t, err)
+	assert.Error(t, p.PresentWeatherStatus(models.GetWeatherStatusResponse{PlaceName: "渋谷区"}, nil))
+	assert.Empty(t, buf.String(), "実行に失敗した場合は何も出力されないはずです")
+}
+
+func TestNewTemplateOption(t *testing.T) {
+	_, err := New("template", nil)
+	assert.Error(t, err)
+
+	p, err := New("template", nil, WithTemplate("{{.}}"))
+	require.NoError(t, err)
+	assert.IsType(t, &TemplatePresenter{}, p)
+}
@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/mattn/go-isatty"
+)
+
+// defaultTerminalWidth は端末の幅を取得できない場合に使用する幅 (文字数) です。
+const defaultTerminalWidth = 80
+
+// isTerminal は w が端末かどうかを返します。
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd()))
+}
+
+// terminalWidth は w が端末の場合はその幅を、それ以外の場合は環境変数 COLUMNS または defaultTerminalWidth を返します。
+func terminalWidth(w io.Writer) int {
+	if f, ok := w.(*os.File); ok && isTerminal(w) {
+		if width := terminalSize(f.Fd()); width > 0 {
+			return width
+		}
+	}
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package presenter
+
+// terminalSize は端末の幅 (文字数) を返します。この環境では取得できないため、常に 0 を返します。
+func terminalSize(fd uintptr) int {
+	return 0
+}
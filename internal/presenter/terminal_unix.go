@@ -0,0 +1,14 @@
+//go:build unix
+
+package presenter
+
+import "golang.org/x/sys/unix"
+
+// terminalSize は端末の幅 (文字数) を返します。取得できない場合は 0 を返します。
+func terminalSize(fd uintptr) int {
+	ws, err := unix.IoctlGetWinsize(int(fd), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0
+	}
+	return int(ws.Col)
+}
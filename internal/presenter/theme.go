@@ -0,0 +1,134 @@
+package presenter
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+	"github.com/eraiza0816/zu2l/internal/output"
+)
+
+// ColorMode は TablePresenter の出力に色を付けるかどうかの指定です。
+type ColorMode string
+
+const (
+	ColorAuto   ColorMode = "auto"   // 出力先が端末で、環境変数 NO_COLOR が設定されていない場合に色を付ける
+	ColorAlways ColorMode = "always" // 常に色を付ける (NO_COLOR より優先)
+	ColorNever  ColorMode = "never"  // 色を付けない
+)
+
+// ColorModes は指定できる ColorMode の一覧です。
+var ColorModes = output.ColorModes
+
+// NoColorEnv は色を付けないことを指定する環境変数です (https://no-color.org/)。
+const NoColorEnv = "NO_COLOR"
+
+// ParseColorMode は文字列を ColorMode に変換します。空文字列は ColorAuto として扱います。
+func ParseColorMode(s string) (ColorMode, error) {
+	switch mode := ColorMode(s); mode {
+	case "":
+		return ColorAuto, nil
+	case ColorAuto, ColorAlways, ColorNever:
+		return mode, nil
+	default:
+		return "", i18n.Errorf("無効な色の指定です: %s (%s のいずれかを指定してください)", s, strings.Join(ColorModes, ", "))
+	}
+}
+
+// Enabled は出力先 w に色を付けるかどうかを返します。
+// ColorAuto の場合、w が端末でない場合や環境変数 NO_COLOR が空でない場合、TERM が dumb の場合は色を付けません。
+func (m ColorMode) Enabled(w io.Writer) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	default:
+		return os.Getenv(NoColorEnv) == "" && os.Getenv("TERM") != "dumb" && isTerminal(w)
+	}
+}
+
+// Theme は TablePresenter で使う色 (ANSI の SGR パラメータ、例: "31"、"38;5;208") のテーマです。空文字列の項目には色を付けません。
+type Theme struct {
+	Name   string
+	Levels map[models.PressureLevelEnum]string // 気圧レベルごとの色
+	Pain   [4]string                           // 痛み予報の区分 (普通・少し痛い・痛い・かなり痛い) ごとの色
+	Accent string                              // グラフの気温など、補助的な値の色
+}
+
+// Themes は組み込みのテーマです。名前は output.ThemeNames と一致させます。
+var Themes = map[string]Theme{
+	// default は16色の端末でも表示できる、緑から赤への配色です。
+	"default": {
+		Name: "default",
+		Levels: map[models.PressureLevelEnum]string{
+			models.Normal:      "32",
+			models.SlightAlert: "33",
+			models.Caution:     "1;33",
+			models.Alert:       "31",
+			models.SevereAlert: "1;31",
+		},
+		Pain:   [4]string{"32", "33", "1;33", "1;31"},
+		Accent: "36",
+	},
+	// 256 は256色の端末向けの、緑から赤への段階的な配色です。
+	"256": {
+		Name: "256",
+		Levels: map[models.PressureLevelEnum]string{
+			models.Normal:      "38;5;34",
+			models.SlightAlert: "38;5;184",
+			models.Caution:     "38;5;214",
+			models.Alert:       "38;5;202",
+			models.SevereAlert: "1;38;5;196",
+		},
+		Pain:   [4]string{"38;5;34", "38;5;184", "38;5;208", "1;38;5;196"},
+		Accent: "38;5;45",
+	},
+	// mono は色を使わず、注意以上の気圧レベルや最も多い痛み予報の区分を太字・下線・反転で強調します。
+	"mono": {
+		Name: "mono",
+		Levels: map[models.PressureLevelEnum]string{
+			models.Caution:     "1",
+			models.Alert:       "1;4",
+			models.SevereAlert: "1;7",
+		},
+		Pain:   [4]string{"1", "1", "1;4", "1;7"},
+		Accent: "2",
+	},
+}
+
+// DefaultTheme は既定のテーマの名前です。
+const DefaultTheme = "default"
+
+// ThemeNames は組み込みのテーマの名前を昇順で返します。
+func ThemeNames() []string {
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupTheme は名前に対応する組み込みのテーマを返します。空文字列は DefaultTheme として扱います。
+func LookupTheme(name string) (Theme, error) {
+	if name == "" {
+		name = DefaultTheme
+	}
+	theme, ok := Themes[name]
+	if !ok {
+		return Theme{}, i18n.Errorf("無効なテーマです: %s (%s のいずれかを指定してください)", name, strings.Join(ThemeNames(), ", "))
+	}
+	return theme, nil
+}
+
+// colorize は enabled が true の場合に text を ANSI の色 color で囲みます。color が空の場合は何もしません。
+func colorize(color string, enabled bool, text string) string {
+	if !enabled || color == "" {
+		return text
+	}
+	return "\x1b[" + color + "m" + text + "\x1b[0m"
+}
@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eraiza0816/zu2l/internal/analysis"
+	"github.com/eraiza0816/zu2l/internal/i18n"
+	"github.com/eraiza0816/zu2l/internal/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLPresenter は Presenter インターフェースを実装し、データをYAML形式で出力します。
+// キー名や値の形式は JSONPresenter と同じです。
+type YAMLPresenter struct {
+	Writer io.Writer // 出力先 (nil の場合は os.Stdout)
+}
+
+func (p *YAMLPresenter) ensureWriter() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+// marshalAndPrint はデータをYAMLに変換し、ライターに出力するヘルパーメソッドです。
+// モデルの JSON タグと MarshalJSON を使うため、一度 JSON にマーシャリングしてから YAML のノードとして読み込みます
+// (YAML は JSON の上位互換のため、キーの順序も保たれます)。
+func (p *YAMLPresenter) marshalAndPrint(data interface{}) error {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return i18n.Errorf("データをJSONにマーシャリングできませんでした: %w", err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(jsonBytes, &node); err != nil {
+		return i18n.Errorf("データをYAMLに変換できませんでした: %w", err)
+	}
+	setBlockStyle(&node)
+
+	encoder := yaml.NewEncoder(p.ensureWriter())
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return i18n.Errorf("YAML出力の書き込みに失敗しました: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return i18n.Errorf("YAML出力の書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// setBlockStyle は JSON から読み込んだノードのフロースタイル ({...}, [...]) と引用符を取り除き、通常のブロックスタイルにします。
+// 文字列のタグは保たれるため、"0" のような数値に見える文字列は引用符付きで出力されます。
+func setBlockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
+
+// PresentPainStatus は痛み予報データをYAML形式で出力します。
+func (p *YAMLPresenter) PresentPainStatus(data models.GetPainStatusResponse) error {
+	return p.marshalAndPrint(data)
+}
+
+// PresentWeatherPoint は地点検索結果データをYAML形式で出力します。
+// kata および keyword パラメータはYAML出力では無視されます。
+func (p *YAMLPresenter) PresentWeatherPoint(data models.GetWeatherPointResponse, kata bool, keyword string) error {
+	return p.marshalAndPrint(data)
+}
+
+// PresentWeatherStatus は気象状況データをYAML形式で出力します。
+// dayOffsets パラメータはYAML出力では無視され、レスポンス全体が1回だけ出力されます。
+func (p *YAMLPresenter) PresentWeatherStatus(data models.GetWeatherStatusResponse, dayOffsets []int) error {
+	return p.marshalAndPrint(data)
+}
+
+// PresentOtenkiASP は Otenki ASP データをYAML形式で出力します。
+// targetDates, cityName, cityCode パラメータはYAML出力では無視されます。
+func (p *YAMLPresenter) PresentOtenkiASP(data models.GetOtenkiASPResponse, targetDates []time.Time, cityName, cityCode string) error {
+	return p.marshalAndPrint(data)
+}
+
+// PresentPressureAnalysis は気圧変化の分析結果をYAML形式で出力します。
+// hourly パラメータはYAML出力では無視され、1時間ごとの変化量も常に出力されます。
+func (p *YAMLPresenter) PresentPressureAnalysis(data analysis.PressureAnalysis, hourly bool) error {
+	return p.marshalAndPrint(data)
+}
+
+// コンパイル時チェック: YAMLPresenter が Presenter インターフェースを実装していることを保証します。
+var _ Presenter = (*YAMLPresenter)(nil)